@@ -0,0 +1,221 @@
+package Text
+
+import (
+	"unicode/utf8"
+)
+
+// Capacity the default growth policies start with when the internal array is still empty
+const minGrowCapacity = 8
+
+// Configures a StringBuilder when it is created
+type Option func(*StringBuilder)
+
+// Limits the capacity of the StringBuilder to maxCapacity runes. Operations which would need more space
+// fail with ErrCapacityExceeded and leave the content unchanged. 0 means no limit.
+func WithMaxCapacity(maxCapacity int) Option {
+	return func(s *StringBuilder) {
+		s.maxCapacity = maxCapacity
+	}
+}
+
+// Sets the policy which decides how much the internal array grows when it is full
+func WithGrowthPolicy(policy GrowthPolicy) Option {
+	return func(s *StringBuilder) {
+		s.growth = policy
+	}
+}
+
+// Sets the culture used to format numbers and dates
+func WithCulture(culture *Culture) Option {
+	return func(s *StringBuilder) {
+		s.culture = culture
+	}
+}
+
+// GrowthPolicy decides the new capacity of a full StringBuilder. NextCapacity gets the current capacity
+// and the capacity which is required at least. Results smaller than required are raised to required
+// and results above the maximum capacity are cut down to it.
+type GrowthPolicy interface {
+	NextCapacity(current int, required int) int
+}
+
+// GrowthPolicyFunc turns an ordinary function into a GrowthPolicy
+type GrowthPolicyFunc func(current int, required int) int
+
+func (f GrowthPolicyFunc) NextCapacity(current int, required int) int {
+	return f(current, required)
+}
+
+// Doubles the capacity until the required runes fit. This is the default.
+func DoublingGrowth() GrowthPolicy {
+	return FactorGrowth(2)
+}
+
+// Multiplies the capacity with factor until the required runes fit, e.g. 1.5 for a more memory friendly growth.
+// Factors of 1 or less grow to exactly the required capacity.
+func FactorGrowth(factor float64) GrowthPolicy {
+	return GrowthPolicyFunc(func(current int, required int) int {
+		if factor <= 1 {
+			return required
+		}
+
+		capacity := current
+		if capacity < minGrowCapacity {
+			capacity = minGrowCapacity
+		}
+		for capacity < required {
+			next := int(float64(capacity) * factor)
+			if next <= capacity {
+				next = capacity + 1
+			}
+			capacity = next
+		}
+
+		return capacity
+	})
+}
+
+// Grows the capacity in steps of increment runes, which keeps the unused space below increment.
+// An increment of 0 or less grows to exactly the required capacity.
+func FixedIncrementGrowth(increment int) GrowthPolicy {
+	return GrowthPolicyFunc(func(current int, required int) int {
+		if increment <= 0 {
+			return required
+		}
+
+		steps := (required - current + increment - 1) / increment
+		return current + steps*increment
+	})
+}
+
+// Returns the number of runes the StringBuilder can hold before it has to grow
+func (s *StringBuilder) Capacity() int {
+	return len(s.data)
+}
+
+// Returns the maximum capacity of the StringBuilder or 0 if there is no limit
+func (s *StringBuilder) MaxCapacity() int {
+	return s.maxCapacity
+}
+
+// Grows the StringBuilder so it can hold at least capacity runes without growing again.
+// Returns ErrCapacityExceeded if capacity is above the maximum capacity.
+func (s *StringBuilder) EnsureCapacity(capacity int) error {
+	if capacity <= len(s.data) {
+		return nil
+	}
+
+	return s.grow(capacity)
+}
+
+// Shrinks the internal array to the current length, releasing all unused capacity
+func (s *StringBuilder) TrimExcess() {
+	if len(s.data) == s.position {
+		return
+	}
+
+	data := make([]rune, s.position)
+	copy(data, s.data)
+	s.data = data
+	s.frozen = 0
+}
+
+// Returns the error of the first chainable call like Append or Replace which failed since the last Clear.
+// Those calls can only fail if the StringBuilder has a maximum capacity.
+func (s *StringBuilder) Err() error {
+	return s.err
+}
+
+// Remembers the first error of a chainable call
+func (s *StringBuilder) setErr(err error) {
+	if s.err == nil {
+		s.err = err
+	}
+}
+
+// Makes sure additional runes fit behind the current content
+func (s *StringBuilder) reserve(additional int) error {
+	if s.position+additional <= len(s.data) {
+		return nil
+	}
+
+	return s.grow(s.position + additional)
+}
+
+// Makes sure the runes of text fit behind the current content. The byte length is an upper bound
+// of the rune count, so the runes are only counted if that bound doesn't fit.
+func (s *StringBuilder) reserveString(text string) error {
+	if s.position+len(text) <= len(s.data) {
+		return nil
+	}
+	if s.maxCapacity <= 0 || s.position+len(text) <= s.maxCapacity {
+		return s.grow(s.position + len(text))
+	}
+
+	return s.reserve(utf8.RuneCountInString(text))
+}
+
+// Same as reserveString for UTF-8 encoded bytes
+func (s *StringBuilder) reserveBytes(p []byte) error {
+	if s.position+len(p) <= len(s.data) {
+		return nil
+	}
+	if s.maxCapacity <= 0 || s.position+len(p) <= s.maxCapacity {
+		return s.grow(s.position + len(p))
+	}
+
+	return s.reserve(utf8.RuneCount(p))
+}
+
+// Moves the content into a new array with space for at least required runes
+func (s *StringBuilder) grow(required int) error {
+	capacity, err := s.newCapacity(required)
+	if err != nil {
+		return err
+	}
+
+	data := make([]rune, capacity)
+	copy(data, s.data[:s.position])
+	s.data = data
+	s.frozen = 0
+
+	return nil
+}
+
+// Returns the capacity the internal array has to grow to, so that required runes fit.
+// While an undo or rollback restores an earlier state, the maximum capacity is not enforced,
+// as the edits are undone one by one and can pass through a longer text. See fitMaxCapacity.
+func (s *StringBuilder) newCapacity(required int) (int, error) {
+	if s.maxCapacity > 0 && required > s.maxCapacity && !s.restoring() {
+		return 0, ErrCapacityExceeded
+	}
+
+	policy := s.growth
+	if policy == nil {
+		policy = defaultGrowth
+	}
+
+	capacity := policy.NextCapacity(len(s.data), required)
+	if capacity < required {
+		capacity = required
+	}
+	if s.maxCapacity > 0 && capacity > s.maxCapacity && required <= s.maxCapacity {
+		capacity = s.maxCapacity
+	}
+
+	return capacity, nil
+}
+
+// Shrinks the internal array back to the maximum capacity after an undo or rollback grew it beyond
+func (s *StringBuilder) fitMaxCapacity() {
+	if s.maxCapacity <= 0 || len(s.data) <= s.maxCapacity || s.position > s.maxCapacity {
+		return
+	}
+
+	data := make([]rune, s.maxCapacity)
+	copy(data, s.data[:s.position])
+	s.data = data
+	s.frozen = 0
+}
+
+var defaultGrowth = DoublingGrowth()
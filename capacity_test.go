@@ -0,0 +1,190 @@
+package Text
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestGrowthPolicies(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   GrowthPolicy
+		current  int
+		required int
+		want     int
+	}{
+		{"Doubling from empty", DoublingGrowth(), 0, 3, 8},
+		{"Doubling", DoublingGrowth(), 8, 9, 16},
+		{"Doubling several times", DoublingGrowth(), 8, 33, 64},
+		{"Factor 1.5", FactorGrowth(1.5), 8, 9, 12},
+		{"Factor 1.5 several times", FactorGrowth(1.5), 8, 20, 27},
+		{"Factor 1 grows exactly", FactorGrowth(1), 8, 20, 20},
+		{"Fixed increment", FixedIncrementGrowth(100), 10, 11, 110},
+		{"Fixed increment several times", FixedIncrementGrowth(100), 10, 250, 310},
+		{"Fixed increment of 0 grows exactly", FixedIncrementGrowth(0), 10, 250, 250},
+		{"Custom", GrowthPolicyFunc(func(current, required int) int { return required + 1 }), 10, 20, 21},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.NextCapacity(tt.current, tt.required); got != tt.want {
+				t.Errorf("NextCapacity(%v, %v) = %v, want %v", tt.current, tt.required, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGrowthPolicyIsUsedWhenGrowing(t *testing.T) {
+	s := NewStringBuilder(10, WithGrowthPolicy(FixedIncrementGrowth(5)))
+
+	s.Append("Hello World")
+
+	if s.Capacity() != 15 {
+		t.Errorf("StringBuilder.Capacity() = %v, want %v", s.Capacity(), 15)
+	}
+}
+
+func TestCapacityIsCutToMaxCapacity(t *testing.T) {
+	s := NewStringBuilder(4, WithMaxCapacity(10))
+
+	s.Append("Hello")
+
+	if s.Capacity() != 8 {
+		t.Errorf("StringBuilder.Capacity() = %v, want %v", s.Capacity(), 8)
+	}
+	s.Append("Worl")
+	if s.Capacity() != 10 || s.Err() != nil {
+		t.Errorf("StringBuilder.Capacity() = %v with error %v, want %v", s.Capacity(), s.Err(), 10)
+	}
+}
+
+func TestChainableCallsKeepFirstError(t *testing.T) {
+	s := NewStringBuilder(0, WithMaxCapacity(8))
+
+	s.Append("Hello").Append(" World").AppendRune('!').Replace("Hello", "Goodbye World")
+
+	if !errors.Is(s.Err(), ErrCapacityExceeded) {
+		t.Errorf("StringBuilder.Err() = %v, want %v", s.Err(), ErrCapacityExceeded)
+	}
+	if s.ToString() != "Hello!" {
+		t.Errorf("StringBuilder.ToString() = %q, want %q", s.ToString(), "Hello!")
+	}
+
+	s.Clear()
+	if s.Err() != nil {
+		t.Errorf("StringBuilder.Err() = %v after Clear, want nil", s.Err())
+	}
+}
+
+func TestMaxCapacityCountsRunesNotBytes(t *testing.T) {
+	s := NewStringBuilder(0, WithMaxCapacity(4))
+
+	s.Append("äöü").AppendList([]string{"ß"})
+
+	if s.Err() != nil || s.ToString() != "äöüß" {
+		t.Errorf("StringBuilder = %q with error %v, want %q", s.ToString(), s.Err(), "äöüß")
+	}
+}
+
+func TestAppendListIsAllOrNothing(t *testing.T) {
+	s := NewStringBuilder(0, WithMaxCapacity(8))
+
+	s.AppendList([]string{"Hello", " ", "World"})
+
+	if !errors.Is(s.Err(), ErrCapacityExceeded) || s.Len() != 0 {
+		t.Errorf("StringBuilder = %q with error %v, want it empty with %v", s.ToString(), s.Err(), ErrCapacityExceeded)
+	}
+}
+
+func TestErrorReturningMethodsReportCapacityExceeded(t *testing.T) {
+	tests := []struct {
+		name string
+		call func(s *StringBuilder) error
+	}{
+		{"Insert", func(s *StringBuilder) error { return s.Insert(0, "World") }},
+		{"Write", func(s *StringBuilder) error { _, err := s.Write([]byte("World")); return err }},
+		{"WriteString", func(s *StringBuilder) error { _, err := s.WriteString("World"); return err }},
+		{"WriteRune", func(s *StringBuilder) error {
+			s.Append("!!!")
+			_, err := s.WriteRune('!')
+			s.Remove(5, 3)
+			return err
+		}},
+		{"ReadFrom", func(s *StringBuilder) error { _, err := s.ReadFrom(strings.NewReader("World")); return err }},
+		{"AppendFormat", func(s *StringBuilder) error { return s.AppendFormat("{0,5}", 1) }},
+		{"AppendFloat", func(s *StringBuilder) error { return s.AppendFloat(1234.5, "N2") }},
+		{"EnsureCapacity", func(s *StringBuilder) error { return s.EnsureCapacity(9) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewStringBuilderFromString("Hello", WithMaxCapacity(8))
+
+			err := tt.call(s)
+
+			if !errors.Is(err, ErrCapacityExceeded) {
+				t.Errorf("%v returned %v, want %v", tt.name, err, ErrCapacityExceeded)
+			}
+			if s.ToString() != "Hello" {
+				t.Errorf("StringBuilder.ToString() = %q, want %q", s.ToString(), "Hello")
+			}
+		})
+	}
+}
+
+func TestNewStringBuilderFromStringAboveMaxCapacity(t *testing.T) {
+	s := NewStringBuilderFromString("Hello World", WithMaxCapacity(5))
+
+	if !errors.Is(s.Err(), ErrCapacityExceeded) || s.Len() != 0 {
+		t.Errorf("StringBuilder = %q with error %v, want it empty with %v", s.ToString(), s.Err(), ErrCapacityExceeded)
+	}
+}
+
+func TestNewStringBuilderClampsInitialCapacity(t *testing.T) {
+	s := NewStringBuilder(100, WithMaxCapacity(10))
+
+	if s.Capacity() != 10 || s.MaxCapacity() != 10 {
+		t.Errorf("StringBuilder capacity = %v, max = %v, want %v", s.Capacity(), s.MaxCapacity(), 10)
+	}
+}
+
+func TestEnsureCapacity(t *testing.T) {
+	s := NewStringBuilderFromString("Hello")
+
+	if err := s.EnsureCapacity(100); err != nil {
+		t.Fatalf("StringBuilder.EnsureCapacity() threw an error: %v", err)
+	}
+
+	if s.Capacity() < 100 || s.ToString() != "Hello" {
+		t.Errorf("StringBuilder = %q with capacity %v, want %q with at least %v", s.ToString(), s.Capacity(), "Hello", 100)
+	}
+}
+
+func TestTrimExcess(t *testing.T) {
+	s := NewStringBuilder(100)
+	s.Append("Hello")
+
+	s.TrimExcess()
+
+	if s.Capacity() != 5 || s.ToString() != "Hello" {
+		t.Errorf("StringBuilder = %q with capacity %v, want %q with %v", s.ToString(), s.Capacity(), "Hello", 5)
+	}
+}
+
+func TestInsertKeepsTextBehindIndex(t *testing.T) {
+	s := NewStringBuilder(20)
+	s.Append("Hello World")
+
+	s.Insert(5, " my dear")
+
+	if s.ToString() != "Hello my dear World" || s.Capacity() != 20 {
+		t.Errorf("StringBuilder = %q with capacity %v, want %q with %v", s.ToString(), s.Capacity(), "Hello my dear World", 20)
+	}
+}
+
+func TestWithCulture(t *testing.T) {
+	s := NewStringBuilder(0, WithCulture(CultureDeDE))
+
+	if s.Culture() != CultureDeDE {
+		t.Errorf("StringBuilder.Culture() = %v, want %v", s.Culture().Name, CultureDeDE.Name)
+	}
+}
@@ -0,0 +1,87 @@
+package Text
+
+// ChangeKind tells which operation changed the content of a StringBuilder
+type ChangeKind int
+
+const (
+	ChangeAppend ChangeKind = iota
+	ChangeInsert
+	ChangeRemove
+	ChangeReplace
+	ChangeReplaceRune
+	ChangeTrim
+	ChangeReverse
+	ChangeSetRune
+	ChangeClear
+	ChangeUndo
+	ChangeRedo
+	ChangeRollback
+	ChangeNormalize
+)
+
+var changeKindNames = [...]string{
+	"Append", "Insert", "Remove", "Replace", "ReplaceRune", "Trim",
+	"Reverse", "SetRune", "Clear", "Undo", "Redo", "Rollback", "Normalize",
+}
+
+func (k ChangeKind) String() string {
+	if k < 0 || int(k) >= len(changeKindNames) {
+		return "Unknown"
+	}
+
+	return changeKindNames[k]
+}
+
+// ChangeEvent describes a single change: the runes in [Start, End) of the text before the change
+// were replaced by Inserted. Applying the events in order to a copy of the text keeps it in sync.
+// Calls which change several places, like Replace, send one event per place.
+type ChangeEvent struct {
+	Kind     ChangeKind
+	Start    int
+	End      int
+	Removed  string
+	Inserted string
+}
+
+type observer struct {
+	id       uint64
+	callback func(ChangeEvent)
+}
+
+// Calls callback after every change of the content. The callback must not change the StringBuilder.
+// Call the returned function to unsubscribe.
+func (s *StringBuilder) OnChange(callback func(ChangeEvent)) (unsubscribe func()) {
+	s.nextObserverID++
+	id := s.nextObserverID
+	s.observers = append(s.observers, observer{id, callback})
+
+	return func() {
+		for i, o := range s.observers {
+			if o.id == id {
+				// A new slice, so a notification which is running right now isn't disturbed
+				s.observers = append(s.observers[:i:i], s.observers[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// Sends the edit to all subscribers
+func (s *StringBuilder) notify(e edit) {
+	if len(s.observers) == 0 {
+		return
+	}
+
+	ev := ChangeEvent{Kind: e.kind, Start: e.start, End: e.start + len(e.removed), Removed: string(e.removed), Inserted: string(e.inserted)}
+	if e.reversed {
+		reversed := make([]rune, s.position)
+		for i, r := range s.data[:s.position] {
+			reversed[s.position-1-i] = r
+		}
+		ev = ChangeEvent{Kind: e.kind, Start: 0, End: s.position, Removed: string(reversed), Inserted: s.ToString()}
+	}
+
+	for _, o := range s.observers {
+		o.callback(ev)
+	}
+}
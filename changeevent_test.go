@@ -0,0 +1,158 @@
+package Text
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestOnChangeEvents(t *testing.T) {
+	tests := []struct {
+		name string
+		edit func(s *StringBuilder)
+		want []ChangeEvent
+	}{
+		{"Append", func(s *StringBuilder) { s.Append("!") }, []ChangeEvent{{ChangeAppend, 13, 13, "", "!"}}},
+		{"AppendLine", func(s *StringBuilder) { s.AppendLine("!") }, []ChangeEvent{{ChangeAppend, 13, 13, "", "!\n"}}},
+		{"AppendFormat", func(s *StringBuilder) { s.AppendFormat("{0}-{1}", 1, 2) }, []ChangeEvent{{ChangeAppend, 13, 13, "", "1-2"}}},
+		{"Insert", func(s *StringBuilder) { s.Insert(1, "x") }, []ChangeEvent{{ChangeInsert, 1, 1, "", "x"}}},
+		{"Remove", func(s *StringBuilder) { s.Remove(1, 5) }, []ChangeEvent{{ChangeRemove, 1, 6, "Hello", ""}}},
+		{"Replace", func(s *StringBuilder) { s.Replace("l", "LL") }, []ChangeEvent{
+			{ChangeReplace, 3, 4, "l", "LL"},
+			{ChangeReplace, 5, 6, "l", "LL"},
+			{ChangeReplace, 12, 13, "l", "LL"},
+		}},
+		{"ReplaceRune", func(s *StringBuilder) { s.ReplaceRune('o', '0') }, []ChangeEvent{
+			{ChangeReplaceRune, 5, 6, "o", "0"},
+			{ChangeReplaceRune, 8, 9, "o", "0"},
+		}},
+		{"Trim", func(s *StringBuilder) { s.Trim() }, []ChangeEvent{
+			{ChangeTrim, 0, 1, " ", ""},
+			{ChangeTrim, 11, 12, " ", ""},
+		}},
+		{"Reverse", func(s *StringBuilder) { s.Reverse() }, []ChangeEvent{{ChangeReverse, 0, 13, " Hello World ", " dlroW olleH "}}},
+		{"SetRuneAt", func(s *StringBuilder) { s.SetRuneAt(1, 'J') }, []ChangeEvent{{ChangeSetRune, 1, 2, "H", "J"}}},
+		{"Clear", func(s *StringBuilder) { s.Clear() }, []ChangeEvent{{ChangeClear, 0, 13, " Hello World ", ""}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewStringBuilderFromString(" Hello World ")
+			var got []ChangeEvent
+			s.OnChange(func(ev ChangeEvent) { got = append(got, ev) })
+
+			tt.edit(s)
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("Got %v events, want %v: %v", len(got), len(tt.want), got)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("Event %v is %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestOnChangeFiresAfterTheChange(t *testing.T) {
+	s := NewStringBuilderFromString("Hello")
+	var seen string
+	s.OnChange(func(ChangeEvent) { seen = s.ToString() })
+
+	s.SetRuneAt(0, 'J')
+
+	if seen != "Jello" {
+		t.Errorf("Callback saw %q, want %q", seen, "Jello")
+	}
+}
+
+func TestOnChangeUnsubscribe(t *testing.T) {
+	s := NewStringBuilder(8)
+	first, second := 0, 0
+	unsubscribe := s.OnChange(func(ChangeEvent) { first++ })
+	s.OnChange(func(ChangeEvent) { second++ })
+
+	s.Append("a")
+	unsubscribe()
+	unsubscribe()
+	s.Append("b")
+
+	if first != 1 || second != 2 {
+		t.Errorf("Callbacks were called %v and %v times, want 1 and 2", first, second)
+	}
+}
+
+func TestOnChangeUnsubscribeWhileNotifying(t *testing.T) {
+	s := NewStringBuilder(8)
+	calls := 0
+	var unsubscribe func()
+	unsubscribe = s.OnChange(func(ChangeEvent) { unsubscribe() })
+	s.OnChange(func(ChangeEvent) { calls++ })
+
+	s.Append("a").Append("b")
+
+	if calls != 2 {
+		t.Errorf("Second callback was called %v times, want 2", calls)
+	}
+}
+
+func TestOnChangeEventsReproduceTheContent(t *testing.T) {
+	s := NewStringBuilderFromString("  Hello World  ")
+	mirror := []rune(s.ToString())
+	s.OnChange(func(ev ChangeEvent) {
+		if string(mirror[ev.Start:ev.End]) != ev.Removed {
+			t.Errorf("%v removed %q, but the mirror has %q", ev.Kind, ev.Removed, string(mirror[ev.Start:ev.End]))
+		}
+		mirror = append(mirror[:ev.Start:ev.Start], append([]rune(ev.Inserted), mirror[ev.End:]...)...)
+	})
+	s.EnableHistory(10)
+
+	s.Trim().Replace("o", "0").AppendLine("!")
+	s.Insert(5, ", dear")
+	s.Remove(0, 2)
+	s.Reverse()
+	s.SetRuneAt(0, '?')
+	s.Undo()
+	s.Undo()
+	s.Redo()
+	tx := s.Begin()
+	s.Append("more").Replace("l", "L")
+	tx.Rollback()
+	s.Clear()
+	s.Undo()
+
+	if string(mirror) != s.ToString() {
+		t.Errorf("Mirror is %q, want %q", string(mirror), s.ToString())
+	}
+}
+
+func TestOnChangeKindsOfReplayedEdits(t *testing.T) {
+	s := NewStringBuilderFromString("Hello")
+	s.EnableHistory(10)
+	s.Remove(0, 1)
+	var kinds []string
+	s.OnChange(func(ev ChangeEvent) { kinds = append(kinds, ev.Kind.String()) })
+
+	s.Undo()
+	s.Redo()
+	tx := s.Begin()
+	s.Append("!")
+	tx.Rollback()
+
+	if got := strings.Join(kinds, ","); got != "Undo,Redo,Append,Rollback" {
+		t.Errorf("Got kinds %v, want Undo,Redo,Append,Rollback", got)
+	}
+}
+
+func TestConcurrentStringBuilderOnChange(t *testing.T) {
+	s := NewConcurrentStringBuilder(8)
+	count := 0
+	unsubscribe := s.OnChange(func(ChangeEvent) { count++ })
+
+	s.Append("a")
+	unsubscribe()
+	s.Append("b")
+
+	if count != 1 {
+		t.Errorf("Callback was called %v times, want 1", count)
+	}
+}
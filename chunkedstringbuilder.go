@@ -0,0 +1,421 @@
+package Text
+
+import (
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Maximum number of runes a chunk of a ChunkedStringBuilder holds if no chunk size is given
+const DefaultChunkSize = 8000
+
+// Smallest chunk which gets allocated, so short texts don't allocate a whole chunk up front
+const minChunkSize = 16
+
+// ChunkedStringBuilder stores its content in a linked list of rune chunks, like the StringBuilder of .NET.
+// When the last chunk is full a new one is added, so growing never copies the existing content.
+// That keeps appending to very large texts free of latency spikes. Use Chunks or WriteTo to read
+// the content without creating one big copy.
+type ChunkedStringBuilder struct {
+	head      *stringChunk
+	tail      *stringChunk
+	chunkSize int
+	length    int
+	// Incomplete rune at the end of the last Write
+	pending pendingRune
+}
+
+// A chunk holds len(runes) runes and never grows beyond cap(runes)
+type stringChunk struct {
+	runes []rune
+	next  *stringChunk
+}
+
+// Creates a new instance of the ChunkedStringBuilder whose chunks hold up to chunkSize runes.
+// A chunkSize of 0 or less selects DefaultChunkSize.
+func NewChunkedStringBuilder(chunkSize int) *ChunkedStringBuilder {
+	return &ChunkedStringBuilder{chunkSize: chunkSize}
+}
+
+// Creates a new instance of the ChunkedStringBuilder with a preallocated text
+func NewChunkedStringBuilderFromString(text string) *ChunkedStringBuilder {
+	s := &ChunkedStringBuilder{}
+	s.Append(text)
+
+	return s
+}
+
+// Appends a text to the ChunkedStringBuilder instance
+func (s *ChunkedStringBuilder) Append(text string) *ChunkedStringBuilder {
+	for _, r := range text {
+		s.appendRune(r)
+	}
+
+	return s
+}
+
+// Appends a text and a new line character to the ChunkedStringBuilder instance
+func (s *ChunkedStringBuilder) AppendLine(text string) *ChunkedStringBuilder {
+	s.Append(text)
+	s.appendRune('\n')
+
+	return s
+}
+
+// Appends a single character to the ChunkedStringBuilder instance
+func (s *ChunkedStringBuilder) AppendRune(char rune) *ChunkedStringBuilder {
+	s.appendRune(char)
+
+	return s
+}
+
+// Appends a single integer to the ChunkedStringBuilder instance
+func (s *ChunkedStringBuilder) AppendInt(integer int) *ChunkedStringBuilder {
+	return s.Append(strconv.Itoa(integer))
+}
+
+// Appends a single boolean to the ChunkedStringBuilder instance
+func (s *ChunkedStringBuilder) AppendBool(flag bool) *ChunkedStringBuilder {
+	return s.Append(strconv.FormatBool(flag))
+}
+
+// Implements the io.Writer interface so the ChunkedStringBuilder can be used with fmt.Fprintf or csv.Writer.
+// An incomplete rune at the end of p is added as utf8.RuneError for every byte and replaced once the next Write
+// completes it. If something else was added in between, the bytes stay utf8.RuneError.
+func (s *ChunkedStringBuilder) Write(p []byte) (int, error) {
+	n := len(p)
+	if s.pending.len > 0 {
+		combined := make([]byte, 0, s.pending.len+len(p))
+		combined = append(append(combined, s.pending.bytes[:s.pending.len]...), p...)
+		s.truncate(s.length - s.pending.len)
+		p = combined
+	}
+
+	for i := 0; i < len(p); {
+		r, size := utf8.DecodeRune(p[i:])
+		s.appendRune(r)
+		i += size
+	}
+	if complete := completeUtf8Length(p); complete < len(p) {
+		s.pending.len = copy(s.pending.bytes[:], p[complete:])
+	}
+
+	return n, nil
+}
+
+// Implements the io.StringWriter interface. Returns the number of bytes of text
+func (s *ChunkedStringBuilder) WriteString(text string) (int, error) {
+	s.Append(text)
+
+	return len(text), nil
+}
+
+// Writes a single character and returns the number of bytes of its UTF-8 encoding
+func (s *ChunkedStringBuilder) WriteRune(char rune) (int, error) {
+	s.appendRune(char)
+
+	return utf8.RuneLen(char), nil
+}
+
+// Implements the io.WriterTo interface. Writes the content chunk by chunk as UTF-8 to w
+// without creating a copy of the whole string first
+func (s *ChunkedStringBuilder) WriteTo(w io.Writer) (int64, error) {
+	buffer := make([]byte, 0, ioBufferSize)
+	var total int64
+
+	flush := func() error {
+		n, err := w.Write(buffer)
+		total += int64(n)
+		if err == nil && n != len(buffer) {
+			err = io.ErrShortWrite
+		}
+		buffer = buffer[:0]
+		return err
+	}
+
+	for c := s.head; c != nil; c = c.next {
+		for _, r := range c.runes {
+			if len(buffer) > ioBufferSize-utf8.UTFMax {
+				if err := flush(); err != nil {
+					return total, err
+				}
+			}
+			buffer = utf8.AppendRune(buffer, r)
+		}
+	}
+	if len(buffer) > 0 {
+		if err := flush(); err != nil {
+			return total, err
+		}
+	}
+
+	return total, nil
+}
+
+// Returns the content as one rune slice per chunk. The slices point into the ChunkedStringBuilder
+// and are only valid until the next change to it.
+func (s *ChunkedStringBuilder) Chunks() [][]rune {
+	chunks := make([][]rune, 0, s.chunkCount())
+	for c := s.head; c != nil; c = c.next {
+		if len(c.runes) > 0 {
+			chunks = append(chunks, c.runes[:len(c.runes):len(c.runes)])
+		}
+	}
+
+	return chunks
+}
+
+// Returns the current length of the represented string
+func (s *ChunkedStringBuilder) Len() int {
+	return s.length
+}
+
+// Returns the represented string
+func (s *ChunkedStringBuilder) ToString() string {
+	var builder strings.Builder
+	builder.Grow(s.length)
+	for c := s.head; c != nil; c = c.next {
+		for _, r := range c.runes {
+			builder.WriteRune(r)
+		}
+	}
+
+	return builder.String()
+}
+
+// Removes all characters from the current instance. The first chunk is kept for reuse.
+func (s *ChunkedStringBuilder) Clear() {
+	if s.head != nil {
+		s.head.runes = s.head.runes[:0]
+		s.head.next = nil
+		s.tail = s.head
+	}
+	s.length = 0
+	s.pending.len = 0
+}
+
+// Gets the rune at the specific position. Panics with a *RangeError if the index is out of range.
+func (s *ChunkedStringBuilder) RuneAt(index int) rune {
+	r, err := s.TryRuneAt(index)
+	if err != nil {
+		panic(err)
+	}
+
+	return r
+}
+
+// Gets the rune at the specific position or returns a *RangeError if the index is out of range
+func (s *ChunkedStringBuilder) TryRuneAt(index int) (rune, error) {
+	if err := checkIndex("RuneAt", index, s.length, false); err != nil {
+		return 0, err
+	}
+
+	c := s.head
+	for index >= len(c.runes) {
+		index -= len(c.runes)
+		c = c.next
+	}
+
+	return c.runes[index], nil
+}
+
+// Returns a substring from start (inclusive) to end (exclusive).
+func (s *ChunkedStringBuilder) Substring(start, end int) (string, error) {
+	if err := checkBounds("Substring", start, end, s.length); err != nil {
+		return "", err
+	}
+
+	r := make([]rune, 0, end-start)
+	offset := 0
+	for c := s.head; c != nil && offset < end; c = c.next {
+		chunkEnd := offset + len(c.runes)
+		if chunkEnd > start {
+			from, to := start-offset, end-offset
+			if from < 0 {
+				from = 0
+			}
+			if to > len(c.runes) {
+				to = len(c.runes)
+			}
+			r = append(r, c.runes[from:to]...)
+		}
+		offset = chunkEnd
+	}
+
+	return string(r), nil
+}
+
+// Returns the first occurrence of the given text in the ChunkedStringBuilder. Returns -1 if not found
+func (s *ChunkedStringBuilder) FindFirst(text string, opts ...SearchOption) int {
+	if o := newSearchOptions(opts); o.transforming() {
+		return findFirst([]rune(s.ToString()), text, o)
+	}
+	occurrences := s.find([]rune(text), false, 1)
+	if len(occurrences) == 0 {
+		return -1
+	}
+
+	return occurrences[0]
+}
+
+// Returns the last occurrence of the given text in the ChunkedStringBuilder. Returns -1 if not found
+func (s *ChunkedStringBuilder) FindLast(text string, opts ...SearchOption) int {
+	if o := newSearchOptions(opts); o.transforming() {
+		return findLast([]rune(s.ToString()), text, o)
+	}
+	occurrences := s.find([]rune(text), true, -1)
+	if len(occurrences) == 0 {
+		return -1
+	}
+
+	return occurrences[len(occurrences)-1]
+}
+
+// Returns all occurrences of the given text in the ChunkedStringBuilder. Returns an empty if no occurrence found.
+// Occurrences which overlap each other are reported unless the NonOverlapping option is given.
+func (s *ChunkedStringBuilder) FindAll(text string, opts ...SearchOption) []int {
+	o := newSearchOptions(opts)
+	if o.transforming() {
+		// The transformed text has to be matched as a whole, as its runes don't line up with the chunks
+		return findAll([]rune(s.ToString()), text, o)
+	}
+
+	return s.find([]rune(text), o.overlapping, -1)
+}
+
+// Replaces all occurrences of oldValue with newValue. The result is written into new chunks in a single pass.
+func (s *ChunkedStringBuilder) Replace(oldValue string, newValue string) *ChunkedStringBuilder {
+	if oldValue == newValue {
+		return s
+	}
+
+	oldValueRunes := []rune(oldValue)
+	occurrences := s.find(oldValueRunes, false, -1)
+	if len(occurrences) == 0 {
+		return s
+	}
+
+	newValueRunes := []rune(newValue)
+	result := ChunkedStringBuilder{chunkSize: s.chunkSize}
+	next, skip, position := 0, 0, 0
+	for c := s.head; c != nil; c = c.next {
+		for _, r := range c.runes {
+			if next < len(occurrences) && position == occurrences[next] {
+				for _, newRune := range newValueRunes {
+					result.appendRune(newRune)
+				}
+				skip = len(oldValueRunes)
+				next++
+			}
+			if skip > 0 {
+				skip--
+			} else {
+				result.appendRune(r)
+			}
+			position++
+		}
+	}
+
+	s.head, s.tail, s.length = result.head, result.tail, result.length
+	s.pending.len = 0
+
+	return s
+}
+
+// Returns up to limit occurrences of needle. Knuth-Morris-Pratt reads every rune exactly once,
+// so the chunks are searched one after another and occurrences spanning a chunk boundary are found as well.
+func (s *ChunkedStringBuilder) find(needle []rune, overlapping bool, limit int) []int {
+	items := make([]int, 0, 8)
+	if len(needle) == 0 || limit == 0 {
+		return items
+	}
+
+	m := &matcher{needle: needle, kmp: kmpTable(needle)}
+	matched, position := 0, 0
+	for c := s.head; c != nil; c = c.next {
+		for _, r := range c.runes {
+			position++
+			matched = m.step(matched, r)
+			if matched < len(needle) {
+				continue
+			}
+
+			items = append(items, position-matched)
+			if len(items) == limit {
+				return items
+			}
+			if overlapping {
+				matched = m.kmp[matched-1]
+			} else {
+				matched = 0
+			}
+		}
+	}
+
+	return items
+}
+
+// Appends r and drops the incomplete rune of the last Write, as it isn't the end of the content anymore
+func (s *ChunkedStringBuilder) appendRune(r rune) {
+	s.pending.len = 0
+	if s.tail == nil || len(s.tail.runes) == cap(s.tail.runes) {
+		s.addChunk()
+	}
+	s.tail.runes = append(s.tail.runes, r)
+	s.length++
+}
+
+// Cuts the content back to length runes
+func (s *ChunkedStringBuilder) truncate(length int) {
+	if cut := s.length - length; cut <= len(s.tail.runes) {
+		s.tail.runes = s.tail.runes[:len(s.tail.runes)-cut]
+		s.length = length
+		return
+	}
+
+	position := 0
+	for c := s.head; c != nil; c = c.next {
+		if position+len(c.runes) >= length {
+			c.runes = c.runes[:length-position]
+			c.next = nil
+			s.tail = c
+			break
+		}
+		position += len(c.runes)
+	}
+	s.length = length
+}
+
+// Adds an empty chunk to the end. Chunks grow with the length up to the chunk size,
+// so the total capacity still doubles while the builder is small.
+func (s *ChunkedStringBuilder) addChunk() {
+	maxSize := s.chunkSize
+	if maxSize <= 0 {
+		maxSize = DefaultChunkSize
+	}
+	size := s.length
+	if size < minChunkSize {
+		size = minChunkSize
+	}
+	if size > maxSize {
+		size = maxSize
+	}
+
+	c := &stringChunk{runes: make([]rune, 0, size)}
+	if s.tail == nil {
+		s.head = c
+	} else {
+		s.tail.next = c
+	}
+	s.tail = c
+}
+
+func (s *ChunkedStringBuilder) chunkCount() int {
+	count := 0
+	for c := s.head; c != nil; c = c.next {
+		count++
+	}
+
+	return count
+}
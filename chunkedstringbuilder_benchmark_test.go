@@ -0,0 +1,28 @@
+package Text
+
+import (
+	"io"
+	"testing"
+)
+
+const csvRow = "4711;Gopher;Hällo World;1234.56;2023-10-20\n"
+
+func BenchmarkChunkedStringBuilderLargeExport(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		s := NewChunkedStringBuilder(0)
+		for i := 0; i < 50000; i++ {
+			s.Append(csvRow)
+		}
+		s.WriteTo(io.Discard)
+	}
+}
+
+func BenchmarkStringBuilderLargeExport(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		s := &StringBuilder{}
+		for i := 0; i < 50000; i++ {
+			s.Append(csvRow)
+		}
+		s.WriteTo(io.Discard)
+	}
+}
@@ -0,0 +1,263 @@
+package Text
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestChunkedStringBuilderAppend(t *testing.T) {
+	const expected string = "Hello Wörld\n42true"
+	s := NewChunkedStringBuilder(4)
+
+	s.Append("Hello").AppendRune(' ').AppendLine("Wörld").AppendInt(42).AppendBool(true)
+
+	if result := s.ToString(); result != expected {
+		t.Errorf("Actual %q, Expected: %q", result, expected)
+	}
+	if result := s.Len(); result != 18 {
+		t.Errorf("ChunkedStringBuilder.Len() = %v, want %v", result, 18)
+	}
+}
+
+func TestChunkedStringBuilderNeverExceedsChunkSize(t *testing.T) {
+	s := NewChunkedStringBuilder(100)
+
+	for i := 0; i < 50; i++ {
+		s.Append("Hello World")
+	}
+
+	chunks := s.Chunks()
+	joined := make([]rune, 0, s.Len())
+	for i, chunk := range chunks {
+		if len(chunk) > 100 {
+			t.Errorf("Chunk %v has %v runes, want at most %v", i, len(chunk), 100)
+		}
+		joined = append(joined, chunk...)
+	}
+	if string(joined) != strings.Repeat("Hello World", 50) {
+		t.Errorf("ChunkedStringBuilder.Chunks() = %q, want the whole text", string(joined))
+	}
+}
+
+func TestChunkedStringBuilderGrowthKeepsChunks(t *testing.T) {
+	s := NewChunkedStringBuilder(16)
+	s.Append(strings.Repeat("a", 16))
+	first := s.Chunks()[0]
+
+	s.Append(strings.Repeat("b", 100))
+
+	if &s.Chunks()[0][0] != &first[0] {
+		t.Errorf("Growing the ChunkedStringBuilder moved the first chunk")
+	}
+}
+
+func TestChunkedStringBuilderFindAcrossChunks(t *testing.T) {
+	s := NewChunkedStringBuilder(4)
+	s.Append("abHälloabcHälloaa")
+
+	if got := s.FindFirst("Hällo"); got != 2 {
+		t.Errorf("ChunkedStringBuilder.FindFirst() = %v, want %v", got, 2)
+	}
+	if got := s.FindLast("Hällo"); got != 10 {
+		t.Errorf("ChunkedStringBuilder.FindLast() = %v, want %v", got, 10)
+	}
+	if got := s.FindAll("Hällo"); !slicesEqual(got, []int{2, 10}) {
+		t.Errorf("ChunkedStringBuilder.FindAll() = %v, want %v", got, []int{2, 10})
+	}
+	if got := s.FindFirst("xyz"); got != -1 {
+		t.Errorf("ChunkedStringBuilder.FindFirst() = %v, want %v", got, -1)
+	}
+}
+
+func TestChunkedStringBuilderFindAllOverlapping(t *testing.T) {
+	s := NewChunkedStringBuilder(3)
+	s.Append("aaaa")
+
+	if got := s.FindAll("aa"); !slicesEqual(got, []int{0, 1, 2}) {
+		t.Errorf("ChunkedStringBuilder.FindAll() = %v, want %v", got, []int{0, 1, 2})
+	}
+	if got := s.FindAll("aa", NonOverlapping()); !slicesEqual(got, []int{0, 2}) {
+		t.Errorf("ChunkedStringBuilder.FindAll(NonOverlapping()) = %v, want %v", got, []int{0, 2})
+	}
+}
+
+func TestChunkedStringBuilderReplace(t *testing.T) {
+	tests := []struct {
+		name     string
+		oldValue string
+		newValue string
+		want     string
+	}{
+		{"Longer", "Hello", "Goodbye", "Goodbye World, Goodbye Gophers"},
+		{"Shorter", "Hello", "Hi", "Hi World, Hi Gophers"},
+		{"Removal", "Hello ", "", "World, Gophers"},
+		{"Not found", "Servus", "Hi", "Hello World, Hello Gophers"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewChunkedStringBuilder(4)
+			s.Append("Hello World, Hello Gophers")
+
+			s.Replace(tt.oldValue, tt.newValue)
+
+			if got := s.ToString(); got != tt.want {
+				t.Errorf("ChunkedStringBuilder.Replace() = %q, want %q", got, tt.want)
+			}
+			if got := s.Len(); got != len([]rune(tt.want)) {
+				t.Errorf("ChunkedStringBuilder.Len() = %v, want %v", got, len([]rune(tt.want)))
+			}
+		})
+	}
+}
+
+func TestChunkedStringBuilderSubstring(t *testing.T) {
+	s := NewChunkedStringBuilder(4)
+	s.Append("Hello Wörld")
+
+	got, err := s.Substring(3, 9)
+	if err != nil {
+		t.Fatalf("ChunkedStringBuilder.Substring() threw an error: %v", err)
+	}
+	if got != "lo Wör" {
+		t.Errorf("ChunkedStringBuilder.Substring() = %q, want %q", got, "lo Wör")
+	}
+	if _, err := s.Substring(5, 12); err == nil {
+		t.Error("Substring should throw error but did not")
+	}
+}
+
+func TestChunkedStringBuilderRuneAt(t *testing.T) {
+	s := NewChunkedStringBuilder(4)
+	s.Append("Hello Wörld")
+
+	if got := s.RuneAt(7); got != 'ö' {
+		t.Errorf("ChunkedStringBuilder.RuneAt() = %q, want %q", got, 'ö')
+	}
+	if _, err := s.TryRuneAt(11); err == nil {
+		t.Error("TryRuneAt should throw error but did not")
+	}
+}
+
+func TestChunkedStringBuilderWrite(t *testing.T) {
+	s := NewChunkedStringBuilder(4)
+	encoded := []byte("Grüße")
+
+	s.Write(encoded[:3])
+	s.Write(encoded[3:])
+	fmt.Fprintf(s, " %d", 42)
+
+	if got := s.ToString(); got != "Grüße 42" {
+		t.Errorf("ChunkedStringBuilder.ToString() = %q, want %q", got, "Grüße 42")
+	}
+}
+
+func TestChunkedStringBuilderWriteSplitsRuneAcrossChunks(t *testing.T) {
+	s := NewChunkedStringBuilder(minChunkSize)
+	s.Append(strings.Repeat("a", minChunkSize-1))
+
+	s.Write([]byte("\xf0\x9f\x98"))
+	s.Write([]byte("\x80!"))
+
+	if got := s.ToString(); got != strings.Repeat("a", minChunkSize-1)+"😀!" {
+		t.Errorf("ChunkedStringBuilder.ToString() = %+q", got)
+	}
+	if s.Len() != minChunkSize+1 {
+		t.Errorf("ChunkedStringBuilder.Len() = %v, want %v", s.Len(), minChunkSize+1)
+	}
+}
+
+func TestChunkedStringBuilderWriteKeepsOrderWithOtherAppends(t *testing.T) {
+	s := NewChunkedStringBuilder(4)
+
+	s.Write([]byte("a\xe2\x82"))
+	s.Append("x")
+	s.WriteString("y")
+	s.Write([]byte("\xac"))
+
+	if got := s.ToString(); got != "a\uFFFD\uFFFDxy\uFFFD" {
+		t.Errorf("ChunkedStringBuilder.ToString() = %+q, want %+q", got, "a\uFFFD\uFFFDxy\uFFFD")
+	}
+}
+
+func TestChunkedStringBuilderWriteWithIncompleteRuneAtTheEnd(t *testing.T) {
+	s := NewChunkedStringBuilder(4)
+	var buffer bytes.Buffer
+
+	s.Write([]byte("trunc\xe2"))
+	s.WriteTo(&buffer)
+
+	if got := s.ToString(); got != "trunc\uFFFD" || s.Len() != 6 {
+		t.Errorf("ChunkedStringBuilder.ToString() = %+q with length %v, want %+q", got, s.Len(), "trunc\uFFFD")
+	}
+	if got := buffer.String(); got != "trunc\uFFFD" {
+		t.Errorf("ChunkedStringBuilder.WriteTo() wrote %+q, want %+q", got, "trunc\uFFFD")
+	}
+}
+
+func TestChunkedStringBuilderWriteTo(t *testing.T) {
+	text := strings.Repeat("Hällo World,", 1000)
+	s := NewChunkedStringBuilder(100)
+	s.Append(text)
+	var buffer bytes.Buffer
+
+	n, err := s.WriteTo(&buffer)
+
+	if err != nil {
+		t.Fatalf("ChunkedStringBuilder.WriteTo() threw an error: %v", err)
+	}
+	if n != int64(len(text)) || buffer.String() != text {
+		t.Errorf("ChunkedStringBuilder.WriteTo() wrote %v bytes, want %v", n, len(text))
+	}
+}
+
+func TestChunkedStringBuilderClear(t *testing.T) {
+	s := NewChunkedStringBuilder(4)
+	s.Append("Hello World")
+
+	s.Clear()
+	s.Append("Hi")
+
+	if got := s.ToString(); got != "Hi" || s.Len() != 2 || len(s.Chunks()) != 1 {
+		t.Errorf("ChunkedStringBuilder = %q with %v chunks, want %q in one chunk", got, len(s.Chunks()), "Hi")
+	}
+}
+
+func TestChunkedStringBuilderMatchesStringBuilder(t *testing.T) {
+	random := rand.New(rand.NewSource(42))
+	alphabet := []rune("abä")
+	s := NewChunkedStringBuilder(5)
+	expected := &StringBuilder{}
+
+	for i := 0; i < 300; i++ {
+		r := alphabet[random.Intn(len(alphabet))]
+		s.AppendRune(r)
+		expected.AppendRune(r)
+	}
+
+	for _, needle := range []string{"ab", "aä", "bab", "äaä"} {
+		if got, want := s.FindAll(needle), expected.FindAll(needle); !slicesEqual(got, want) {
+			t.Errorf("ChunkedStringBuilder.FindAll(%q) = %v, want %v", needle, got, want)
+		}
+	}
+	s.Replace("ab", "xyz")
+	expected.Replace("ab", "xyz")
+	if s.ToString() != expected.ToString() {
+		t.Errorf("ChunkedStringBuilder.Replace() = %q, want %q", s.ToString(), expected.ToString())
+	}
+}
+
+func TestChunkedStringBuilderWriteDoesNotCompleteAppendedPlaceholders(t *testing.T) {
+	s := NewChunkedStringBuilder(0)
+
+	s.Write([]byte("\xe2\x82"))
+	s.Clear()
+	s.Write([]byte("\xef\xbf\xbd\xef\xbf\xbd"))
+	s.Write([]byte("\xac"))
+
+	if got := s.ToString(); got != "\uFFFD\uFFFD\uFFFD" {
+		t.Errorf("ChunkedStringBuilder.ToString() = %+q, want %+q", got, "\uFFFD\uFFFD\uFFFD")
+	}
+}
@@ -0,0 +1,217 @@
+package Text
+
+import "strconv"
+
+// StringComparison decides which texts a search considers equal, like StringComparison in .NET
+type StringComparison int
+
+const (
+	// Compares the runes as they are. This is the default.
+	Ordinal StringComparison = iota
+	// Ignores the case with the simple case folding of Unicode, which maps every rune to a single rune.
+	// "Σ", "σ" and "ς" match each other, "ß" and "SS" don't.
+	OrdinalIgnoreCase
+	// Ignores the case with the full case folding of Unicode, so "ß" also matches "SS" and "ﬁ" matches "FI".
+	// An occurrence can have another length than the needle.
+	InvariantIgnoreCase
+	// Same as InvariantIgnoreCase, except that for Turkish and Azerbaijani cultures "I" matches "ı" and "İ"
+	// matches "i". The culture of the StringBuilder is used, types without a culture compare like InvariantIgnoreCase.
+	CultureIgnoreCase
+)
+
+func (c StringComparison) String() string {
+	switch c {
+	case Ordinal:
+		return "Ordinal"
+	case OrdinalIgnoreCase:
+		return "OrdinalIgnoreCase"
+	case InvariantIgnoreCase:
+		return "InvariantIgnoreCase"
+	case CultureIgnoreCase:
+		return "CultureIgnoreCase"
+	}
+
+	return "StringComparison(" + strconv.Itoa(int(c)) + ")"
+}
+
+// Compares the text with the given StringComparison. Occurrences never split a rune, so "ss" isn't found
+// in "ß" even though "ß" matches "SS".
+func WithComparison(comparison StringComparison) SearchOption {
+	return func(o *searchOptions) {
+		o.comparison = comparison
+	}
+}
+
+// Reports whether text and needle have to be transformed before they can be compared rune by rune
+func (o searchOptions) transforming() bool {
+	return o.canonical || o.comparison != Ordinal
+}
+
+func (o searchOptions) turkic() bool {
+	return o.comparison == CultureIgnoreCase && o.culture != nil && o.culture.turkicCasing()
+}
+
+// Transforms text into the form in which equal texts consist of the same runes.
+// If origins isn't nil, it is replaced by the origin of every transformed rune, see appendOrigins.
+func (o searchOptions) transform(text []rune, origins *[]int) []rune {
+	if o.canonical {
+		text = decompose(text, false, origins)
+	}
+	if o.comparison != Ordinal {
+		text = o.fold(text, origins)
+		if o.canonical {
+			// Folding can produce runes which decompose, e.g. "ᾈ" folds to "ἀι"
+			text = decompose(text, false, origins)
+		}
+	}
+
+	return text
+}
+
+// Case folds the text according to the comparison
+func (o searchOptions) fold(text []rune, origins *[]int) []rune {
+	full := o.comparison != OrdinalIgnoreCase
+	turkic := o.turkic()
+	result := make([]rune, 0, len(text))
+	var foldedOrigins []int
+	for i, r := range text {
+		before := len(result)
+		if full {
+			result = appendFullFold(result, r, turkic)
+		} else {
+			result = append(result, simpleFold(r, turkic))
+		}
+		foldedOrigins = appendOrigins(foldedOrigins, origins, i, len(result)-before)
+	}
+	if origins != nil {
+		*origins = foldedOrigins
+	}
+
+	return result
+}
+
+// Returns the simple case folding of r
+func simpleFold(r rune, turkic bool) rune {
+	if turkic {
+		if folded, ok := turkicCaseFoldings[r]; ok {
+			return folded
+		}
+	}
+	if r < 0x80 {
+		if 'A' <= r && r <= 'Z' {
+			return r + 'a' - 'A'
+		}
+		return r
+	}
+	if folded, ok := simpleCaseFoldings[r]; ok {
+		return folded
+	}
+
+	return r
+}
+
+// Appends the full case folding of r, which can consist of up to three runes
+func appendFullFold(result []rune, r rune, turkic bool) []rune {
+	if r >= 0x80 && !(turkic && r == 0x0130) {
+		if folded, ok := fullCaseFoldings[r]; ok {
+			return append(result, []rune(folded)...)
+		}
+	}
+
+	return append(result, simpleFold(r, turkic))
+}
+
+// A transformation maps every rune of its result to the index of the rune of the original text it comes from.
+// Transformations are chained by passing the origins of the previous one: origins points to nil before the first
+// transformation, which stands for every rune coming from itself. Appends the origin of the rune at index
+// count times.
+func appendOrigins(result []int, origins *[]int, index, count int) []int {
+	if origins == nil {
+		return result
+	}
+	if *origins != nil {
+		index = (*origins)[index]
+	}
+	for ; count > 0; count-- {
+		result = append(result, index)
+	}
+
+	return result
+}
+
+// Returns up to limit occurrences of the transformed needle in the transformed haystack, mapped back to haystack.
+// Occurrences which don't cover whole runes of haystack are skipped.
+func findTransformed(haystack []rune, needle string, o searchOptions, limit int) []occurrence {
+	items := make([]occurrence, 0, 8)
+	pattern := o.transform([]rune(needle), nil)
+	if len(pattern) == 0 || limit == 0 {
+		return items
+	}
+
+	var origins []int
+	text := o.transform(haystack, &origins)
+	// counts[i] is the number of transformed runes which come from haystack[:i]
+	counts := make([]int, len(haystack)+1)
+	for _, origin := range origins {
+		counts[origin+1]++
+	}
+	for i := 1; i < len(counts); i++ {
+		counts[i] += counts[i-1]
+	}
+
+	m := newMatcher(pattern)
+	for from := 0; ; {
+		i := m.index(text, from)
+		if i < 0 {
+			return items
+		}
+
+		end := i + len(pattern)
+		if occ, ok := transformedOccurrence(text, origins, counts, i, end, o.canonical); ok {
+			items = append(items, occ)
+			if len(items) == limit {
+				return items
+			}
+			if !o.overlapping {
+				from = end
+				continue
+			}
+		}
+		from = i + 1
+	}
+}
+
+// Maps the transformed runes from start to end back to the runes they come from. Fails if those runes
+// transform into more than the matched runes or, for canonical equivalence, if a combining mark follows the match.
+func transformedOccurrence(text []rune, origins []int, counts []int, start, end int, canonical bool) (occurrence, bool) {
+	lo, hi := origins[start], origins[start]
+	for _, origin := range origins[start:end] {
+		if origin < lo {
+			lo = origin
+		}
+		if origin > hi {
+			hi = origin
+		}
+	}
+	hi++
+
+	if counts[hi]-counts[lo] != end-start {
+		return occurrence{}, false
+	}
+	if canonical && end < len(text) && combiningClass(text[end]) != 0 {
+		return occurrence{}, false
+	}
+
+	return occurrence{lo, hi}, true
+}
+
+// Reports whether the runes a and b are equal under the comparison, which uses the simple case folding
+// as single runes can't be compared with the full one
+func (o searchOptions) equalRunes(a, b rune) bool {
+	if a == b || o.comparison == Ordinal {
+		return a == b
+	}
+
+	turkic := o.turkic()
+	return simpleFold(a, turkic) == simpleFold(b, turkic)
+}
@@ -0,0 +1,280 @@
+package Text
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFindWithComparison(t *testing.T) {
+	tests := []struct {
+		name       string
+		text       string
+		needle     string
+		comparison StringComparison
+		want       []int
+	}{
+		{"Ordinal", "Go GO go", "go", Ordinal, []int{6}},
+		{"ASCII", "Go GO go", "go", OrdinalIgnoreCase, []int{0, 3, 6}},
+		{"Final sigma", "Σσς", "σ", OrdinalIgnoreCase, []int{0, 1, 2}},
+		{"Sharp s with simple folding", "Straße", "STRASSE", OrdinalIgnoreCase, []int{}},
+		{"Sharp s with full folding", "Straße STRASSE", "strasse", InvariantIgnoreCase, []int{0, 7}},
+		{"Sharp s as needle", "STRASSE", "ß", InvariantIgnoreCase, []int{4}},
+		{"Does not split a rune", "Straße", "s", InvariantIgnoreCase, []int{0}},
+		{"Ligature", "ﬁle", "FILE", InvariantIgnoreCase, []int{0}},
+		{"Dotted capital I", "Iıİi", "i", InvariantIgnoreCase, []int{0, 3}},
+		{"Culture without Turkish casing", "Iıİi", "i", CultureIgnoreCase, []int{0, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewStringBuilderFromString(tt.text)
+
+			got := s.FindAll(tt.needle, WithComparison(tt.comparison))
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FindAll(%q) = %v, want %v", tt.needle, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFindCherokeeIgnoringCase(t *testing.T) {
+	s := NewStringBuilderFromString("\u13A0 \uAB70 \u13F0 \u13F8")
+
+	for _, needle := range []string{"\u13A0", "\uAB70"} {
+		if got := s.FindAll(needle, WithComparison(OrdinalIgnoreCase)); !reflect.DeepEqual(got, []int{0, 2}) {
+			t.Errorf("FindAll(%+q) = %v, want [0 2]", needle, got)
+		}
+	}
+	for _, needle := range []string{"\u13F0", "\u13F8"} {
+		if got := s.FindAll(needle, WithComparison(InvariantIgnoreCase)); !reflect.DeepEqual(got, []int{4, 6}) {
+			t.Errorf("FindAll(%+q) = %v, want [4 6]", needle, got)
+		}
+	}
+}
+
+func TestCaseFoldingIsStable(t *testing.T) {
+	for r := range simpleCaseFoldings {
+		if folded := simpleFold(r, false); simpleFold(folded, false) != folded {
+			t.Errorf("Simple folding of %U is %U, which folds to %U", r, folded, simpleFold(folded, false))
+		}
+	}
+	for r := range turkicCaseFoldings {
+		if folded := simpleFold(r, true); simpleFold(folded, true) != folded {
+			t.Errorf("Turkic folding of %U is %U, which folds to %U", r, folded, simpleFold(folded, true))
+		}
+	}
+	for r := range fullCaseFoldings {
+		folded := appendFullFold(nil, r, false)
+		var twice []rune
+		for _, f := range folded {
+			twice = appendFullFold(twice, f, false)
+		}
+		if string(twice) != string(folded) {
+			t.Errorf("Full folding of %U is %+q, which folds to %+q", r, string(folded), string(twice))
+		}
+	}
+}
+
+func TestFindWithTurkishCulture(t *testing.T) {
+	s := NewStringBuilderFromString("Iıİi", WithCulture(CultureTrTR))
+
+	dotless := s.FindAll("ı", WithComparison(CultureIgnoreCase))
+	dotted := s.FindAll("i", WithComparison(CultureIgnoreCase))
+	invariant := s.FindAll("i", WithComparison(InvariantIgnoreCase))
+
+	if !reflect.DeepEqual(dotless, []int{0, 1}) {
+		t.Errorf("FindAll(ı) = %v, want [0 1]", dotless)
+	}
+	if !reflect.DeepEqual(dotted, []int{2, 3}) {
+		t.Errorf("FindAll(i) = %v, want [2 3]", dotted)
+	}
+	if !reflect.DeepEqual(invariant, []int{0, 3}) {
+		t.Errorf("FindAll(i) with InvariantIgnoreCase = %v, want [0 3]", invariant)
+	}
+}
+
+func TestFindFirstAndLastWithComparison(t *testing.T) {
+	s := NewStringBuilderFromString("Straße STRASSE")
+
+	first := s.FindFirst("STRASSE", WithComparison(InvariantIgnoreCase))
+	last := s.FindLast("strasse", WithComparison(InvariantIgnoreCase))
+
+	if first != 0 {
+		t.Errorf("FindFirst() = %d, want 0", first)
+	}
+	if last != 7 {
+		t.Errorf("FindLast() = %d, want 7", last)
+	}
+}
+
+func TestReplaceWithComparisonKeepsLengthsRight(t *testing.T) {
+	s := NewStringBuilderFromString("Straße, STRASSE, ﬁle", WithHistory(10))
+	var events []ChangeEvent
+	s.OnChange(func(ev ChangeEvent) { events = append(events, ev) })
+
+	s.Replace("strasse", "Weg", WithComparison(InvariantIgnoreCase))
+	s.ReplaceLast("FILE", "doc", WithComparison(InvariantIgnoreCase))
+
+	if s.ToString() != "Weg, Weg, doc" {
+		t.Errorf("Got %q, want %q", s.ToString(), "Weg, Weg, doc")
+	}
+	want := []ChangeEvent{
+		{ChangeReplace, 0, 6, "Straße", "Weg"},
+		{ChangeReplace, 5, 12, "STRASSE", "Weg"},
+		{ChangeReplace, 10, 13, "ﬁle", "doc"},
+	}
+	if !reflect.DeepEqual(events, want) {
+		t.Errorf("Got events %+q, want %+q", events, want)
+	}
+	s.Undo()
+	s.Undo()
+	if s.ToString() != "Straße, STRASSE, ﬁle" {
+		t.Errorf("Got %q after Undo", s.ToString())
+	}
+}
+
+func TestReplaceWithComparisonAndSameValue(t *testing.T) {
+	s := NewStringBuilderFromString("GO go")
+
+	s.Replace("go", "go", WithComparison(OrdinalIgnoreCase))
+
+	if s.ToString() != "go go" {
+		t.Errorf("Got %q, want %q", s.ToString(), "go go")
+	}
+}
+
+func TestReplaceRuneWithComparison(t *testing.T) {
+	tests := []struct {
+		name       string
+		culture    *Culture
+		comparison StringComparison
+		want       string
+	}{
+		{"Ordinal", nil, Ordinal, "Iıİx"},
+		{"Ignore case", nil, OrdinalIgnoreCase, "xıİx"},
+		{"Turkish", CultureTrTR, CultureIgnoreCase, "Iıxx"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewStringBuilderFromString("Iıİi", WithCulture(tt.culture))
+
+			s.ReplaceRune('i', 'x', WithComparison(tt.comparison))
+
+			if s.ToString() != tt.want {
+				t.Errorf("Got %q, want %q", s.ToString(), tt.want)
+			}
+		})
+	}
+}
+
+func TestReplaceRuneWithComparisonRecordsTheReplacedRune(t *testing.T) {
+	s := NewStringBuilderFromString("Σς", WithHistory(10))
+
+	s.ReplaceRune('σ', '*', WithComparison(OrdinalIgnoreCase))
+	s.Undo()
+
+	if s.ToString() != "Σς" {
+		t.Errorf("Got %q after Undo, want %q", s.ToString(), "Σς")
+	}
+}
+
+func TestFindWithComparisonAndCanonicalEquivalence(t *testing.T) {
+	s := NewStringBuilderFromString("CAF\u00C9 \u0130")
+
+	cafe := s.FindFirst("cafe\u0301", WithComparison(InvariantIgnoreCase), CanonicalEquivalence())
+	dotted := s.FindFirst("i\u0307", WithComparison(InvariantIgnoreCase), CanonicalEquivalence())
+	withoutMark := s.FindFirst("cafe", WithComparison(InvariantIgnoreCase), CanonicalEquivalence())
+
+	if cafe != 0 {
+		t.Errorf("FindFirst(cafe\u0301) = %d, want 0", cafe)
+	}
+	if dotted != 5 {
+		t.Errorf("FindFirst(i\u0307) = %d, want 5", dotted)
+	}
+	if withoutMark != -1 {
+		t.Errorf("FindFirst(cafe) = %d, want -1", withoutMark)
+	}
+}
+
+func TestSnapshotFindWithCultureComparison(t *testing.T) {
+	s := NewStringBuilderFromString("İstanbul", WithCulture(CultureTrTR))
+	snapshot := s.Snapshot()
+
+	got := snapshot.FindFirst("istanbul", WithComparison(CultureIgnoreCase))
+
+	if got != 0 {
+		t.Errorf("FindFirst() = %d, want 0", got)
+	}
+}
+
+func TestConcurrentStringBuilderWithComparison(t *testing.T) {
+	s := NewConcurrentStringBuilderFromString("Go GO go")
+
+	s.ReplaceRune('g', 'n', WithComparison(OrdinalIgnoreCase))
+
+	if got := s.FindAll("NO", WithComparison(OrdinalIgnoreCase)); !reflect.DeepEqual(got, []int{0, 3, 6}) {
+		t.Errorf("FindAll() = %v, want [0 3 6]", got)
+	}
+}
+
+func TestFindWithOptionsOfEveryBuilder(t *testing.T) {
+	const text = "Straße STRASSE Cafe\u0301 CAF\u00C9"
+	chunked := NewChunkedStringBuilder(4)
+	chunked.Append(text)
+	type finder interface {
+		FindFirst(text string, opts ...SearchOption) int
+		FindLast(text string, opts ...SearchOption) int
+		FindAll(text string, opts ...SearchOption) []int
+	}
+	builders := []struct {
+		name    string
+		builder finder
+	}{
+		{"StringBuilder", NewStringBuilderFromString(text)},
+		{"Utf8StringBuilder", NewUtf8StringBuilderFromString(text)},
+		{"ChunkedStringBuilder", chunked},
+		{"GapBuffer", NewGapBufferFromString(text)},
+		{"Rope", NewRopeFromString(text)},
+	}
+	for _, b := range builders {
+		t.Run(b.name, func(t *testing.T) {
+			strasse := b.builder.FindAll("strasse", WithComparison(InvariantIgnoreCase))
+			cafe := b.builder.FindAll("cafe\u0301", WithComparison(OrdinalIgnoreCase), CanonicalEquivalence())
+			first := b.builder.FindFirst("STRASSE", WithComparison(InvariantIgnoreCase))
+			last := b.builder.FindLast("cafe\u0301", WithComparison(OrdinalIgnoreCase), CanonicalEquivalence())
+
+			if !reflect.DeepEqual(strasse, []int{0, 7}) {
+				t.Errorf("FindAll(strasse) = %v, want [0 7]", strasse)
+			}
+			if !reflect.DeepEqual(cafe, []int{15, 21}) {
+				t.Errorf("FindAll(café) = %v, want [15 21]", cafe)
+			}
+			if first != 0 {
+				t.Errorf("FindFirst(STRASSE) = %d, want 0", first)
+			}
+			if last != 21 {
+				t.Errorf("FindLast(café) = %d, want 21", last)
+			}
+		})
+	}
+}
+
+func TestSpillingStringBuilderFindFirstWithComparison(t *testing.T) {
+	s := NewSpillingStringBuilder(4, t.TempDir())
+	s.SetCulture(CultureTrTR).Append("Hello İstanbul")
+
+	got := s.FindFirst("istanbul", WithComparison(CultureIgnoreCase))
+
+	if got != 6 {
+		t.Errorf("FindFirst() = %d, want 6", got)
+	}
+}
+
+func TestStringComparisonString(t *testing.T) {
+	if got := InvariantIgnoreCase.String(); got != "InvariantIgnoreCase" {
+		t.Errorf("String() = %q, want InvariantIgnoreCase", got)
+	}
+	if got := StringComparison(9).String(); got != "StringComparison(9)" {
+		t.Errorf("String() = %q, want StringComparison(9)", got)
+	}
+}
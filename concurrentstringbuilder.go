@@ -0,0 +1,763 @@
+package Text
+
+import (
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ConcurrentStringBuilder is a StringBuilder which can be shared between goroutines.
+// Writes are serialized, while reads like FindFirst, Len or ToString can run in parallel.
+type ConcurrentStringBuilder struct {
+	mutex   sync.RWMutex
+	builder StringBuilder
+}
+
+// Creates a new instance of the ConcurrentStringBuilder with preallocated array
+func NewConcurrentStringBuilder(initialCapacity int, opts ...Option) *ConcurrentStringBuilder {
+	return &ConcurrentStringBuilder{builder: *NewStringBuilder(initialCapacity, opts...)}
+}
+
+// Creates a new instance of the ConcurrentStringBuilder with a preallocated text
+func NewConcurrentStringBuilderFromString(text string, opts ...Option) *ConcurrentStringBuilder {
+	return &ConcurrentStringBuilder{builder: *NewStringBuilderFromString(text, opts...)}
+}
+
+// Returns the represented string and clears the builder in one step,
+// so no write can happen between reading and resetting the content
+func (s *ConcurrentStringBuilder) Swap() string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	text := s.builder.ToString()
+	s.builder.Clear()
+
+	return text
+}
+
+// Appends a text to the ConcurrentStringBuilder instance
+func (s *ConcurrentStringBuilder) Append(text string) *ConcurrentStringBuilder {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.builder.Append(text)
+
+	return s
+}
+
+// Appends a text and a new line character to the ConcurrentStringBuilder instance
+func (s *ConcurrentStringBuilder) AppendLine(text string) *ConcurrentStringBuilder {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.builder.AppendLine(text)
+
+	return s
+}
+
+// Appends a single character to the ConcurrentStringBuilder instance
+func (s *ConcurrentStringBuilder) AppendRune(char rune) *ConcurrentStringBuilder {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.builder.AppendRune(char)
+
+	return s
+}
+
+// Appends a single integer to the ConcurrentStringBuilder instance
+func (s *ConcurrentStringBuilder) AppendInt(integer int) *ConcurrentStringBuilder {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.builder.AppendInt(integer)
+
+	return s
+}
+
+// Appends a single boolean to the ConcurrentStringBuilder instance
+func (s *ConcurrentStringBuilder) AppendBool(flag bool) *ConcurrentStringBuilder {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.builder.AppendBool(flag)
+
+	return s
+}
+
+// Appends a list of strings to the ConcurrentStringBuilder instance
+func (s *ConcurrentStringBuilder) AppendList(words []string) *ConcurrentStringBuilder {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.builder.AppendList(words)
+
+	return s
+}
+
+// Appends the format string with every format item replaced by the corresponding argument, see StringBuilder.AppendFormat
+func (s *ConcurrentStringBuilder) AppendFormat(format string, args ...any) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.builder.AppendFormat(format, args...)
+}
+
+// Sets the culture used to format numbers and dates. nil selects the InvariantCulture.
+func (s *ConcurrentStringBuilder) SetCulture(culture *Culture) *ConcurrentStringBuilder {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.builder.SetCulture(culture)
+
+	return s
+}
+
+// Returns the culture used to format numbers and dates
+func (s *ConcurrentStringBuilder) Culture() *Culture {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.builder.Culture()
+}
+
+// Appends the integer formatted with a .NET numeric format, see StringBuilder.AppendInt64
+func (s *ConcurrentStringBuilder) AppendInt64(value int64, format string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.builder.AppendInt64(value, format)
+}
+
+// Appends the unsigned integer formatted with a .NET numeric format, see StringBuilder.AppendInt64
+func (s *ConcurrentStringBuilder) AppendUint64(value uint64, format string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.builder.AppendUint64(value, format)
+}
+
+// Appends the floating-point number formatted with a .NET numeric format, see StringBuilder.AppendFloat
+func (s *ConcurrentStringBuilder) AppendFloat(value float64, format string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.builder.AppendFloat(value, format)
+}
+
+// Appends the time formatted with a .NET date and time format, see StringBuilder.AppendTime
+func (s *ConcurrentStringBuilder) AppendTime(value time.Time, format string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.builder.AppendTime(value, format)
+}
+
+// Returns the current length of the represented string
+func (s *ConcurrentStringBuilder) Len() int {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.builder.Len()
+}
+
+// Returns the represented string
+func (s *ConcurrentStringBuilder) ToString() string {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.builder.ToString()
+}
+
+// Removes length runes starting at start
+func (s *ConcurrentStringBuilder) Remove(start int, length int) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.builder.Remove(start, length)
+}
+
+// Inserts the text at the given index
+func (s *ConcurrentStringBuilder) Insert(index int, text string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.builder.Insert(index, text)
+}
+
+// Removes all characters from the current instance. The internal array will stay the same.
+func (s *ConcurrentStringBuilder) Clear() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.builder.Clear()
+}
+
+// Gets the rune at the specific position. Panics with a *RangeError if the index is out of range.
+func (s *ConcurrentStringBuilder) RuneAt(index int) rune {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.builder.RuneAt(index)
+}
+
+// Gets the rune at the specific position or returns a *RangeError if the index is out of range
+func (s *ConcurrentStringBuilder) TryRuneAt(index int) (rune, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.builder.TryRuneAt(index)
+}
+
+// Sets the rune at the specific position
+func (s *ConcurrentStringBuilder) SetRuneAt(index int, val rune) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.builder.SetRuneAt(index, val)
+}
+
+// Returns a copy of the string builder as a rune slice.
+// Unlike StringBuilder it doesn't share the internal array as other goroutines keep writing to it.
+func (s *ConcurrentStringBuilder) AsRuneSlice() []rune {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return append([]rune(nil), s.builder.runes()...)
+}
+
+// Returns a copy of the string builder as a rune slice, see AsRuneSlice
+func (s *ConcurrentStringBuilder) AsRuneArray() []rune {
+	return s.AsRuneSlice()
+}
+
+// Returns the first occurrence of the given text in the string builder. Returns -1 if not found
+func (s *ConcurrentStringBuilder) FindFirst(text string, opts ...SearchOption) int {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.builder.FindFirst(text, opts...)
+}
+
+// Returns the last occurrence of the given text in the string builder. Returns -1 if not found
+func (s *ConcurrentStringBuilder) FindLast(text string, opts ...SearchOption) int {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.builder.FindLast(text, opts...)
+}
+
+// Returns all occurrences of the given text in the string builder. Returns an empty if no occurrence found.
+func (s *ConcurrentStringBuilder) FindAll(text string, opts ...SearchOption) []int {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.builder.FindAll(text, opts...)
+}
+
+// Returns the rune offsets [start, end) of the leftmost match of re or nil if there is none
+func (s *ConcurrentStringBuilder) FindRegex(re *regexp.Regexp) []int {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.builder.FindRegex(re)
+}
+
+// Returns the rune offsets [start, end) of up to n successive matches of re. A negative n returns all matches.
+func (s *ConcurrentStringBuilder) FindAllRegex(re *regexp.Regexp, n int) [][]int {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.builder.FindAllRegex(re, n)
+}
+
+// Replaces all matches of re with the template, in which $1 or ${name} stand for the text of a submatch
+func (s *ConcurrentStringBuilder) ReplaceRegex(re *regexp.Regexp, template string) *ConcurrentStringBuilder {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.builder.ReplaceRegex(re, template)
+
+	return s
+}
+
+// Replaces all matches of re with the result of replace. The lock is held while replace runs,
+// so it must not call the ConcurrentStringBuilder.
+func (s *ConcurrentStringBuilder) ReplaceRegexFunc(re *regexp.Regexp, replace func(match []string) string) *ConcurrentStringBuilder {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.builder.ReplaceRegexFunc(re, replace)
+
+	return s
+}
+
+// Replaces all occurrences of oldValue with newValue
+func (s *ConcurrentStringBuilder) ReplaceRune(oldValue rune, newValue rune, opts ...SearchOption) *ConcurrentStringBuilder {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.builder.ReplaceRune(oldValue, newValue, opts...)
+
+	return s
+}
+
+// Replaces all occurrences of oldValue with newValue
+func (s *ConcurrentStringBuilder) Replace(oldValue string, newValue string, opts ...SearchOption) *ConcurrentStringBuilder {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.builder.Replace(oldValue, newValue, opts...)
+
+	return s
+}
+
+// Replaces the first occurrence of oldValue with newValue
+func (s *ConcurrentStringBuilder) ReplaceFirst(oldValue string, newValue string, opts ...SearchOption) *ConcurrentStringBuilder {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.builder.ReplaceFirst(oldValue, newValue, opts...)
+
+	return s
+}
+
+// Replaces the last occurrence of oldValue with newValue
+func (s *ConcurrentStringBuilder) ReplaceLast(oldValue string, newValue string, opts ...SearchOption) *ConcurrentStringBuilder {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.builder.ReplaceLast(oldValue, newValue, opts...)
+
+	return s
+}
+
+// Replaces the first n occurrences of oldValue with newValue. A negative n replaces all occurrences.
+func (s *ConcurrentStringBuilder) ReplaceN(oldValue string, newValue string, n int, opts ...SearchOption) *ConcurrentStringBuilder {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.builder.ReplaceN(oldValue, newValue, n, opts...)
+
+	return s
+}
+
+// Trims the given characters from the start and end of the string builder or all whitespaces if no characters are given
+func (s *ConcurrentStringBuilder) Trim(chars ...rune) *ConcurrentStringBuilder {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.builder.Trim(chars...)
+
+	return s
+}
+
+// Trims the given characters from the start of the string builder or all whitespaces if no characters are given
+func (s *ConcurrentStringBuilder) TrimStart(chars ...rune) *ConcurrentStringBuilder {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.builder.TrimStart(chars...)
+
+	return s
+}
+
+// Trims the given characters from the end of the string builder or all whitespaces if no characters are given
+func (s *ConcurrentStringBuilder) TrimEnd(chars ...rune) *ConcurrentStringBuilder {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.builder.TrimEnd(chars...)
+
+	return s
+}
+
+// Reverses the characters of a string builder
+func (s *ConcurrentStringBuilder) Reverse() *ConcurrentStringBuilder {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.builder.Reverse()
+
+	return s
+}
+
+// Returns a substring from start (inclusive) to end (exclusive).
+func (s *ConcurrentStringBuilder) Substring(start, end int) (string, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.builder.Substring(start, end)
+}
+
+// Appends the UTF-8 encoded bytes to the string builder. Implements io.Writer.
+func (s *ConcurrentStringBuilder) Write(p []byte) (int, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.builder.Write(p)
+}
+
+// Appends the text to the string builder. Implements io.StringWriter.
+func (s *ConcurrentStringBuilder) WriteString(text string) (int, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.builder.WriteString(text)
+}
+
+// Appends a single byte of UTF-8 encoded text. Implements io.ByteWriter.
+func (s *ConcurrentStringBuilder) WriteByte(c byte) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.builder.WriteByte(c)
+}
+
+// Appends a single character and returns its length in bytes
+func (s *ConcurrentStringBuilder) WriteRune(char rune) (int, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.builder.WriteRune(char)
+}
+
+// Appends everything read from r until io.EOF. Implements io.ReaderFrom.
+// The builder stays locked for writes until r is drained.
+func (s *ConcurrentStringBuilder) ReadFrom(r io.Reader) (int64, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.builder.ReadFrom(r)
+}
+
+// Writes the UTF-8 encoded content to w. Implements io.WriterTo.
+func (s *ConcurrentStringBuilder) WriteTo(w io.Writer) (int64, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.builder.WriteTo(w)
+}
+
+// Returns a reader over a snapshot of the current content
+func (s *ConcurrentStringBuilder) NewReader() *strings.Reader {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.builder.NewReader()
+}
+
+// Returns the number of runes the ConcurrentStringBuilder can hold before it has to grow
+func (s *ConcurrentStringBuilder) Capacity() int {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.builder.Capacity()
+}
+
+// Returns the maximum capacity of the ConcurrentStringBuilder or 0 if there is no limit
+func (s *ConcurrentStringBuilder) MaxCapacity() int {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.builder.MaxCapacity()
+}
+
+// Grows the ConcurrentStringBuilder so it can hold at least capacity runes without growing again
+func (s *ConcurrentStringBuilder) EnsureCapacity(capacity int) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.builder.EnsureCapacity(capacity)
+}
+
+// Shrinks the internal array to the current length
+func (s *ConcurrentStringBuilder) TrimExcess() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.builder.TrimExcess()
+}
+
+// Returns the error of the first chainable call which failed since the last Clear
+func (s *ConcurrentStringBuilder) Err() error {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.builder.Err()
+}
+
+// Starts recording edits so they can be undone with Undo, keeping up to depth undo steps
+func (s *ConcurrentStringBuilder) EnableHistory(depth int) *ConcurrentStringBuilder {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.builder.EnableHistory(depth)
+
+	return s
+}
+
+// Stops recording edits and drops the whole history
+func (s *ConcurrentStringBuilder) DisableHistory() *ConcurrentStringBuilder {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.builder.DisableHistory()
+
+	return s
+}
+
+// Bundles all following edits until the matching EndGroup into one undo step
+func (s *ConcurrentStringBuilder) BeginGroup() *ConcurrentStringBuilder {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.builder.BeginGroup()
+
+	return s
+}
+
+// Closes the group opened by BeginGroup
+func (s *ConcurrentStringBuilder) EndGroup() *ConcurrentStringBuilder {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.builder.EndGroup()
+
+	return s
+}
+
+// Reports whether there is an edit which can be undone
+func (s *ConcurrentStringBuilder) CanUndo() bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.builder.CanUndo()
+}
+
+// Reports whether there is an undone edit which can be redone
+func (s *ConcurrentStringBuilder) CanRedo() bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.builder.CanRedo()
+}
+
+// Reverts the last undo step and reports whether there was one
+func (s *ConcurrentStringBuilder) Undo() bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.builder.Undo()
+}
+
+// Applies the last undone step again and reports whether there was one
+func (s *ConcurrentStringBuilder) Redo() bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.builder.Redo()
+}
+
+// Starts a transaction, see StringBuilder.Begin. Commit and Rollback of the transaction lock the builder.
+// The builder is not locked while the transaction is open, so the transaction covers the edits of all goroutines:
+// Rollback also reverts what other goroutines changed in the meantime.
+func (s *ConcurrentStringBuilder) Begin() *Transaction {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	tx := s.builder.Begin()
+	tx.locker = &s.mutex
+
+	return tx
+}
+
+// Returns a read-only view of the current content without copying it. The snapshot can be read
+// from any goroutine while the ConcurrentStringBuilder keeps changing.
+func (s *ConcurrentStringBuilder) Snapshot() *Snapshot {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.builder.Snapshot()
+}
+
+// Returns a copy of the ConcurrentStringBuilder which shares the memory until one of them changes its content
+func (s *ConcurrentStringBuilder) Clone() *ConcurrentStringBuilder {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return &ConcurrentStringBuilder{builder: *s.builder.Clone()}
+}
+
+// Places a marker at the given position, see StringBuilder.Mark. The marker locks the builder when it is accessed.
+func (s *ConcurrentStringBuilder) Mark(position int) (*Marker, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	m, err := s.builder.Mark(position)
+	if m != nil {
+		m.mutex = &s.mutex
+	}
+
+	return m, err
+}
+
+// Calls callback after every change of the content, see StringBuilder.OnChange.
+// The callback runs while the builder is locked, so it must not call the ConcurrentStringBuilder.
+func (s *ConcurrentStringBuilder) OnChange(callback func(ChangeEvent)) (unsubscribe func()) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	remove := s.builder.OnChange(callback)
+
+	return func() {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+
+		remove()
+	}
+}
+
+// Returns the number of lines, see StringBuilder.LineCount
+func (s *ConcurrentStringBuilder) LineCount() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.builder.LineCount()
+}
+
+// Returns the 1-based line n without its line ending
+func (s *ConcurrentStringBuilder) Line(n int) (string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.builder.Line(n)
+}
+
+// Returns the rune offset at which the 1-based line n starts
+func (s *ConcurrentStringBuilder) LineStart(n int) (int, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.builder.LineStart(n)
+}
+
+// Converts a rune offset into a 1-based line and column
+func (s *ConcurrentStringBuilder) OffsetToLineCol(offset int) (line int, col int, err error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.builder.OffsetToLineCol(offset)
+}
+
+// Converts a 1-based line and column into a rune offset
+func (s *ConcurrentStringBuilder) LineColToOffset(line int, col int) (int, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.builder.LineColToOffset(line, col)
+}
+
+// Converts a rune offset into an offset in UTF-16 code units
+func (s *ConcurrentStringBuilder) RuneToUTF16(offset int) (int, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.builder.RuneToUTF16(offset)
+}
+
+// Converts an offset in UTF-16 code units into a rune offset
+func (s *ConcurrentStringBuilder) UTF16ToRune(offset int) (int, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.builder.UTF16ToRune(offset)
+}
+
+// Converts an offset in UTF-8 bytes into a rune offset
+func (s *ConcurrentStringBuilder) ByteToRune(offset int) (int, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.builder.ByteToRune(offset)
+}
+
+// Converts a rune offset into an offset in UTF-8 bytes
+func (s *ConcurrentStringBuilder) RuneToByte(offset int) (int, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.builder.RuneToByte(offset)
+}
+
+// Replaces the text in r with newText like a TextEdit of the Language Server Protocol
+func (s *ConcurrentStringBuilder) ApplyTextEdit(r Range, newText string, encoding PositionEncoding) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.builder.ApplyTextEdit(r, newText, encoding)
+}
+
+// Returns the number of grapheme clusters, see StringBuilder.GraphemeCount
+func (s *ConcurrentStringBuilder) GraphemeCount() int {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.builder.GraphemeCount()
+}
+
+// Returns the grapheme cluster with the given index
+func (s *ConcurrentStringBuilder) GraphemeAt(index int) (string, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.builder.GraphemeAt(index)
+}
+
+// Returns the grapheme clusters from start (inclusive) to end (exclusive)
+func (s *ConcurrentStringBuilder) SubstringGraphemes(start, end int) (string, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.builder.SubstringGraphemes(start, end)
+}
+
+// Reverses the order of the grapheme clusters
+func (s *ConcurrentStringBuilder) ReverseGraphemes() *ConcurrentStringBuilder {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.builder.ReverseGraphemes()
+
+	return s
+}
+
+// Shortens the text to at most n grapheme clusters, see StringBuilder.TruncateGraphemes
+func (s *ConcurrentStringBuilder) TruncateGraphemes(n int, ellipsis string) *ConcurrentStringBuilder {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.builder.TruncateGraphemes(n, ellipsis)
+
+	return s
+}
+
+// Brings the text into the given normalization form, see StringBuilder.Normalize
+func (s *ConcurrentStringBuilder) Normalize(form NormalizationForm) *ConcurrentStringBuilder {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.builder.Normalize(form)
+
+	return s
+}
+
+// Reports whether the text is in the given normalization form
+func (s *ConcurrentStringBuilder) IsNormalized(form NormalizationForm) bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.builder.IsNormalized(form)
+}
@@ -0,0 +1,150 @@
+package Text
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestConcurrentAppend(t *testing.T) {
+	const goroutines, appends = 8, 1000
+	s := NewConcurrentStringBuilder(0)
+
+	var wg sync.WaitGroup
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < appends; j++ {
+				s.Append("ab")
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := s.Len(); got != goroutines*appends*2 {
+		t.Errorf("ConcurrentStringBuilder.Len() = %v, want %v", got, goroutines*appends*2)
+	}
+	if got := s.ToString(); got != strings.Repeat("ab", goroutines*appends) {
+		t.Errorf("ConcurrentStringBuilder.Append() interleaved the appended texts")
+	}
+}
+
+func TestConcurrentMixedOperations(t *testing.T) {
+	s := NewConcurrentStringBuilderFromString("Hello World")
+
+	var wg sync.WaitGroup
+	run := func(operation func(i int)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 500; i++ {
+				operation(i)
+			}
+		}()
+	}
+	run(func(i int) { s.Append("World") })
+	run(func(i int) { s.Insert(0, "Hello") })
+	run(func(i int) { s.Replace("World", "Earth") })
+	run(func(i int) { s.ReplaceFirst("Earth", "World") })
+	run(func(i int) { _ = s.ToString() })
+	run(func(i int) { _ = s.FindAll("Hello") })
+	run(func(i int) { _, _ = s.Substring(0, 5) })
+	run(func(i int) { s.AppendFormat("{0:N2}", i) })
+	wg.Wait()
+
+	if !strings.HasPrefix(s.ToString(), "Hello") {
+		t.Errorf("ConcurrentStringBuilder.ToString() = %q, want a prefix of %q", s.ToString()[:10], "Hello")
+	}
+}
+
+func TestConcurrentSwap(t *testing.T) {
+	const writers, appends = 4, 1000
+	s := NewConcurrentStringBuilder(0)
+	var collected strings.Builder
+
+	var wg sync.WaitGroup
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < appends; j++ {
+				s.AppendRune('x')
+			}
+		}()
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			collected.WriteString(s.Swap())
+		}
+	}()
+	wg.Wait()
+	<-done
+	collected.WriteString(s.Swap())
+
+	if got := collected.Len(); got != writers*appends {
+		t.Errorf("ConcurrentStringBuilder.Swap() lost or duplicated content, got %v runes, want %v", got, writers*appends)
+	}
+	if got := s.Len(); got != 0 {
+		t.Errorf("ConcurrentStringBuilder.Len() after Swap() = %v, want 0", got)
+	}
+}
+
+func TestConcurrentAsRuneSliceIsACopy(t *testing.T) {
+	s := NewConcurrentStringBuilderFromString("abc")
+
+	runes := s.AsRuneSlice()
+	runes[0] = 'x'
+
+	if got := s.ToString(); got != "abc" {
+		t.Errorf("ConcurrentStringBuilder.AsRuneSlice() shares the internal array, got %q", got)
+	}
+}
+
+func TestConcurrentStringBuilderHasTheSameMethods(t *testing.T) {
+	concurrent := reflect.TypeOf(&ConcurrentStringBuilder{})
+	builder := reflect.TypeOf(&StringBuilder{})
+
+	for i := 0; i < builder.NumMethod(); i++ {
+		name := builder.Method(i).Name
+		if _, ok := concurrent.MethodByName(name); !ok {
+			t.Errorf("ConcurrentStringBuilder is missing the method %v", name)
+		}
+	}
+}
+
+func TestConcurrentStringBuilderTransaction(t *testing.T) {
+	s := NewConcurrentStringBuilderFromString("Hello")
+	tx := s.Begin()
+	s.Append(" World")
+
+	if err := tx.Rollback(); err != nil {
+		t.Fatalf("Transaction.Rollback() threw an error: %v", err)
+	}
+
+	if got := s.ToString(); got != "Hello" {
+		t.Errorf("ConcurrentStringBuilder after Rollback = %q, want %q", got, "Hello")
+	}
+}
+
+func TestConcurrentStringBuilderRollbackRevertsEditsOfOtherGoroutines(t *testing.T) {
+	s := NewConcurrentStringBuilderFromString("Hello")
+	tx := s.Begin()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		s.Append(" World")
+		s.Insert(0, ">> ")
+	}()
+	wg.Wait()
+	tx.Rollback()
+
+	if got := s.ToString(); got != "Hello" {
+		t.Errorf("ConcurrentStringBuilder after Rollback = %q, want %q", got, "Hello")
+	}
+}
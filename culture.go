@@ -0,0 +1,276 @@
+package Text
+
+import "strings"
+
+// Culture holds the culture specific symbols and patterns used to format numbers and dates.
+// It mirrors NumberFormatInfo and DateTimeFormatInfo of .NET, including the numbering of the currency
+// and percent patterns, so values of an existing .NET culture can be copied over as they are.
+// Create your own instance to plug in a culture which isn't predefined.
+type Culture struct {
+	Name string
+
+	NegativeSign     string
+	DecimalSeparator string
+	GroupSeparator   string
+	// Sizes of the digit groups from the decimal separator to the left. The last size repeats, a last size of 0
+	// leaves the remaining digits ungrouped. {3} gives 1,234,567 and {3, 2} gives 12,34,567.
+	GroupSizes             []int
+	NumberDecimalDigits    int
+	NaNSymbol              string
+	PositiveInfinitySymbol string
+	NegativeInfinitySymbol string
+
+	CurrencySymbol        string
+	CurrencyDecimalDigits int
+	// 0: $n, 1: n$, 2: $ n, 3: n $
+	CurrencyPositivePattern int
+	// 0: ($n), 1: -$n, 2: $-n, 3: $n-, 4: (n$), 5: -n$, 6: n-$, 7: n$-, 8: -n $, 9: -$ n, 10: n $-,
+	// 11: $ n-, 12: $ -n, 13: n- $, 14: ($ n), 15: (n $)
+	CurrencyNegativePattern int
+
+	PercentSymbol        string
+	PerMilleSymbol       string
+	PercentDecimalDigits int
+	// 0: n %, 1: n%, 2: %n, 3: % n
+	PercentPositivePattern int
+	// 0: -n %, 1: -n%, 2: -%n, 3: %-n, 4: %n-, 5: n-%, 6: n%-, 7: -% n, 8: n %-, 9: % n-, 10: % -n, 11: n- %
+	PercentNegativePattern int
+
+	DateSeparator         string
+	TimeSeparator         string
+	ShortDatePattern      string
+	LongDatePattern       string
+	ShortTimePattern      string
+	LongTimePattern       string
+	MonthDayPattern       string
+	YearMonthPattern      string
+	AMDesignator          string
+	PMDesignator          string
+	MonthNames            [12]string
+	AbbreviatedMonthNames [12]string
+	// Starting with Sunday
+	DayNames [7]string
+	// Starting with Sunday
+	AbbreviatedDayNames [7]string
+}
+
+var englishMonthNames = [12]string{
+	"January", "February", "March", "April", "May", "June",
+	"July", "August", "September", "October", "November", "December",
+}
+
+var englishAbbreviatedMonthNames = [12]string{
+	"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec",
+}
+
+var englishDayNames = [7]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}
+
+var englishAbbreviatedDayNames = [7]string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}
+
+// InvariantCulture is culture independent and used when no other culture is set
+var InvariantCulture = &Culture{
+	NegativeSign:            "-",
+	DecimalSeparator:        ".",
+	GroupSeparator:          ",",
+	GroupSizes:              []int{3},
+	NumberDecimalDigits:     2,
+	NaNSymbol:               "NaN",
+	PositiveInfinitySymbol:  "Infinity",
+	NegativeInfinitySymbol:  "-Infinity",
+	CurrencySymbol:          "¤",
+	CurrencyDecimalDigits:   2,
+	CurrencyPositivePattern: 0,
+	CurrencyNegativePattern: 0,
+	PercentSymbol:           "%",
+	PerMilleSymbol:          "‰",
+	PercentDecimalDigits:    2,
+	PercentPositivePattern:  0,
+	PercentNegativePattern:  0,
+	DateSeparator:           "/",
+	TimeSeparator:           ":",
+	ShortDatePattern:        "MM/dd/yyyy",
+	LongDatePattern:         "dddd, dd MMMM yyyy",
+	ShortTimePattern:        "HH:mm",
+	LongTimePattern:         "HH:mm:ss",
+	MonthDayPattern:         "MMMM dd",
+	YearMonthPattern:        "yyyy MMMM",
+	AMDesignator:            "AM",
+	PMDesignator:            "PM",
+	MonthNames:              englishMonthNames,
+	AbbreviatedMonthNames:   englishAbbreviatedMonthNames,
+	DayNames:                englishDayNames,
+	AbbreviatedDayNames:     englishAbbreviatedDayNames,
+}
+
+// CultureEnUS is English as used in the United States (en-US)
+var CultureEnUS = &Culture{
+	Name:                    "en-US",
+	NegativeSign:            "-",
+	DecimalSeparator:        ".",
+	GroupSeparator:          ",",
+	GroupSizes:              []int{3},
+	NumberDecimalDigits:     2,
+	NaNSymbol:               "NaN",
+	PositiveInfinitySymbol:  "Infinity",
+	NegativeInfinitySymbol:  "-Infinity",
+	CurrencySymbol:          "$",
+	CurrencyDecimalDigits:   2,
+	CurrencyPositivePattern: 0,
+	CurrencyNegativePattern: 0,
+	PercentSymbol:           "%",
+	PerMilleSymbol:          "‰",
+	PercentDecimalDigits:    2,
+	PercentPositivePattern:  0,
+	PercentNegativePattern:  0,
+	DateSeparator:           "/",
+	TimeSeparator:           ":",
+	ShortDatePattern:        "M/d/yyyy",
+	LongDatePattern:         "dddd, MMMM d, yyyy",
+	ShortTimePattern:        "h:mm tt",
+	LongTimePattern:         "h:mm:ss tt",
+	MonthDayPattern:         "MMMM d",
+	YearMonthPattern:        "MMMM yyyy",
+	AMDesignator:            "AM",
+	PMDesignator:            "PM",
+	MonthNames:              englishMonthNames,
+	AbbreviatedMonthNames:   englishAbbreviatedMonthNames,
+	DayNames:                englishDayNames,
+	AbbreviatedDayNames:     englishAbbreviatedDayNames,
+}
+
+// CultureDeDE is German as used in Germany (de-DE)
+var CultureDeDE = &Culture{
+	Name:                    "de-DE",
+	NegativeSign:            "-",
+	DecimalSeparator:        ",",
+	GroupSeparator:          ".",
+	GroupSizes:              []int{3},
+	NumberDecimalDigits:     2,
+	NaNSymbol:               "NaN",
+	PositiveInfinitySymbol:  "+unendlich",
+	NegativeInfinitySymbol:  "-unendlich",
+	CurrencySymbol:          "€",
+	CurrencyDecimalDigits:   2,
+	CurrencyPositivePattern: 3,
+	CurrencyNegativePattern: 8,
+	PercentSymbol:           "%",
+	PerMilleSymbol:          "‰",
+	PercentDecimalDigits:    2,
+	PercentPositivePattern:  0,
+	PercentNegativePattern:  0,
+	DateSeparator:           ".",
+	TimeSeparator:           ":",
+	ShortDatePattern:        "dd.MM.yyyy",
+	LongDatePattern:         "dddd, d. MMMM yyyy",
+	ShortTimePattern:        "HH:mm",
+	LongTimePattern:         "HH:mm:ss",
+	MonthDayPattern:         "dd MMMM",
+	YearMonthPattern:        "MMMM yyyy",
+	AMDesignator:            "",
+	PMDesignator:            "",
+	MonthNames: [12]string{
+		"Januar", "Februar", "März", "April", "Mai", "Juni",
+		"Juli", "August", "September", "Oktober", "November", "Dezember",
+	},
+	AbbreviatedMonthNames: [12]string{
+		"Jan", "Feb", "Mrz", "Apr", "Mai", "Jun", "Jul", "Aug", "Sep", "Okt", "Nov", "Dez",
+	},
+	DayNames:            [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+	AbbreviatedDayNames: [7]string{"So", "Mo", "Di", "Mi", "Do", "Fr", "Sa"},
+}
+
+// CultureTrTR is Turkish as used in Turkey (tr-TR). Besides formatting it changes the case insensitive
+// comparison of CultureIgnoreCase, where "I" matches "ı" and "İ" matches "i".
+var CultureTrTR = &Culture{
+	Name:                    "tr-TR",
+	NegativeSign:            "-",
+	DecimalSeparator:        ",",
+	GroupSeparator:          ".",
+	GroupSizes:              []int{3},
+	NumberDecimalDigits:     2,
+	NaNSymbol:               "NaN",
+	PositiveInfinitySymbol:  "∞",
+	NegativeInfinitySymbol:  "-∞",
+	CurrencySymbol:          "₺",
+	CurrencyDecimalDigits:   2,
+	CurrencyPositivePattern: 0,
+	CurrencyNegativePattern: 1,
+	PercentSymbol:           "%",
+	PerMilleSymbol:          "‰",
+	PercentDecimalDigits:    2,
+	PercentPositivePattern:  2,
+	PercentNegativePattern:  2,
+	DateSeparator:           ".",
+	TimeSeparator:           ":",
+	ShortDatePattern:        "d.MM.yyyy",
+	LongDatePattern:         "d MMMM yyyy dddd",
+	ShortTimePattern:        "HH:mm",
+	LongTimePattern:         "HH:mm:ss",
+	MonthDayPattern:         "d MMMM",
+	YearMonthPattern:        "MMMM yyyy",
+	AMDesignator:            "ÖÖ",
+	PMDesignator:            "ÖS",
+	MonthNames: [12]string{
+		"Ocak", "Şubat", "Mart", "Nisan", "Mayıs", "Haziran",
+		"Temmuz", "Ağustos", "Eylül", "Ekim", "Kasım", "Aralık",
+	},
+	AbbreviatedMonthNames: [12]string{
+		"Oca", "Şub", "Mar", "Nis", "May", "Haz", "Tem", "Ağu", "Eyl", "Eki", "Kas", "Ara",
+	},
+	DayNames:            [7]string{"Pazar", "Pazartesi", "Salı", "Çarşamba", "Perşembe", "Cuma", "Cumartesi"},
+	AbbreviatedDayNames: [7]string{"Paz", "Pzt", "Sal", "Çar", "Per", "Cum", "Cmt"},
+}
+
+var currencyPositivePatterns = [...]string{"$n", "n$", "$ n", "n $"}
+
+var currencyNegativePatterns = [...]string{
+	"($n)", "-$n", "$-n", "$n-", "(n$)", "-n$", "n-$", "n$-",
+	"-n $", "-$ n", "n $-", "$ n-", "$ -n", "n- $", "($ n)", "(n $)",
+}
+
+var percentPositivePatterns = [...]string{"n %", "n%", "%n", "% n"}
+
+var percentNegativePatterns = [...]string{
+	"-n %", "-n%", "-%n", "%-n", "%n-", "n-%", "n%-", "-% n", "n %-", "% n-", "% -n", "n- %",
+}
+
+// Returns the culture or the invariant culture if it is nil
+func cultureOrInvariant(culture *Culture) *Culture {
+	if culture == nil {
+		return InvariantCulture
+	}
+
+	return culture
+}
+
+// Reports whether the culture cases the dotted and dotless i like Turkish and Azerbaijani
+func (c *Culture) turkicCasing() bool {
+	language := c.Name
+	if i := strings.IndexByte(language, '-'); i >= 0 {
+		language = language[:i]
+	}
+
+	return strings.EqualFold(language, "tr") || strings.EqualFold(language, "az")
+}
+
+// Reports whether a digit group ends right in front of the digit at position (counted from the right, starting at 0)
+func (c *Culture) isGroupBoundary(position int) bool {
+	if position <= 0 || len(c.GroupSizes) == 0 {
+		return false
+	}
+
+	boundary := 0
+	for i := 0; ; i++ {
+		size := c.GroupSizes[len(c.GroupSizes)-1]
+		if i < len(c.GroupSizes) {
+			size = c.GroupSizes[i]
+		}
+		if size <= 0 {
+			return false
+		}
+		boundary += size
+		if boundary >= position {
+			return boundary == position
+		}
+	}
+}
@@ -0,0 +1,282 @@
+package Text
+
+import (
+	"math"
+	"strings"
+)
+
+const perMille = "‰"
+
+// One section of a custom numeric format like "#,##0.00"
+type customNumberSection struct {
+	text string
+	// Number of digit placeholders in total and in front of the decimal point
+	digitCount int
+	decimalPos int
+	// Placeholder positions of the first and behind the last '0'
+	firstZero int
+	lastZero  int
+	groups    bool
+	// Power of ten the value gets multiplied with because of '%', '‰' and scaling commas
+	scale      int
+	scientific bool
+}
+
+// Appends d formatted with a .NET custom numeric format. Up to three sections separated by ';' format positive,
+// negative and zero values. Characters which aren't placeholders or specifiers are copied as they are.
+func appendCustomNumber(dst []byte, d decimalNumber, format string, c *Culture) []byte {
+	sections, count := splitNumberSections(format)
+	pick := func(index int) int {
+		if index < count && sections[index] != "" {
+			return index
+		}
+		return 0
+	}
+
+	index := 0
+	if d.isZero() {
+		index = pick(2)
+	} else if d.negative {
+		index = pick(1)
+	}
+
+	section := parseCustomNumberSection(sections[index])
+	if !d.isZero() {
+		d.exp += section.scale
+		if section.scientific {
+			d = d.rounded(section.digitCount)
+		} else {
+			d = d.rounded(d.exp + section.digitCount - section.decimalPos)
+		}
+		// A value which rounds to zero uses the zero section
+		if d.isZero() && pick(2) != index {
+			index = pick(2)
+			section = parseCustomNumberSection(sections[index])
+		}
+	}
+
+	return section.appendNumber(dst, d, index == 0, c)
+}
+
+// Splits the format into its sections. Separators inside of quotes or after a backslash don't count.
+// Everything behind a third separator is ignored.
+func splitNumberSections(format string) ([3]string, int) {
+	var sections [3]string
+	count, start := 0, 0
+	for i := 0; i < len(format); i++ {
+		switch format[i] {
+		case '\'', '"':
+			i = skipQuoted(format, i)
+		case '\\':
+			i++
+		case ';':
+			sections[count] = format[start:i]
+			count++
+			start = i + 1
+			if count == len(sections) {
+				return sections, count
+			}
+		}
+	}
+	sections[count] = format[start:]
+
+	return sections, count + 1
+}
+
+// Returns the position of the quote closing the one at start or the end of the text
+func skipQuoted(text string, start int) int {
+	end := strings.IndexByte(text[start+1:], text[start])
+	if end < 0 {
+		return len(text)
+	}
+
+	return start + 1 + end
+}
+
+func parseCustomNumberSection(text string) customNumberSection {
+	s := customNumberSection{text: text, decimalPos: -1, firstZero: math.MaxInt32}
+	groupPos, groupCount := -1, 0
+	for i := 0; i < len(text); i++ {
+		switch text[i] {
+		case '#':
+			s.digitCount++
+		case '0':
+			if s.firstZero == math.MaxInt32 {
+				s.firstZero = s.digitCount
+			}
+			s.digitCount++
+			s.lastZero = s.digitCount
+		case '.':
+			if s.decimalPos < 0 {
+				s.decimalPos = s.digitCount
+			}
+		case ',':
+			// Commas between digits group them, commas right in front of the decimal point scale by 1000 each
+			if s.digitCount == 0 || s.decimalPos >= 0 {
+				break
+			}
+			if groupPos >= 0 {
+				if groupPos == s.digitCount {
+					groupCount++
+					break
+				}
+				s.groups = true
+			}
+			groupPos, groupCount = s.digitCount, 1
+		case '%':
+			s.scale += 2
+		case '\'', '"':
+			i = skipQuoted(text, i)
+		case '\\':
+			i++
+		case 'E', 'e':
+			j := i + 1
+			if j < len(text) && (text[j] == '+' || text[j] == '-') {
+				j++
+			}
+			if j < len(text) && text[j] == '0' {
+				for j < len(text) && text[j] == '0' {
+					j++
+				}
+				s.scientific = true
+				i = j - 1
+			}
+		default:
+			if strings.HasPrefix(text[i:], perMille) {
+				s.scale += 3
+			}
+		}
+	}
+
+	if s.decimalPos < 0 {
+		s.decimalPos = s.digitCount
+	}
+	if groupPos >= 0 {
+		if groupPos == s.decimalPos {
+			s.scale -= 3 * groupCount
+		} else {
+			s.groups = true
+		}
+	}
+
+	return s
+}
+
+// Appends the rounded number by walking through the section. digPos counts down the position of the
+// next digit relative to the decimal point, so digits beyond the placeholders go to the first one.
+func (s *customNumberSection) appendNumber(dst []byte, d decimalNumber, withSign bool, c *Culture) []byte {
+	minIntegerDigits := 0
+	if s.firstZero < s.decimalPos {
+		minIntegerDigits = s.decimalPos - s.firstZero
+	}
+	minDecimals := 0
+	if s.lastZero > s.decimalPos {
+		minDecimals = s.lastZero - s.decimalPos
+	}
+
+	digPos, adjust := s.decimalPos, 0
+	if !s.scientific {
+		if d.exp > digPos {
+			digPos = d.exp
+		}
+		adjust = d.exp - s.decimalPos
+	}
+
+	if withSign && d.negative {
+		dst = append(dst, c.NegativeSign...)
+	}
+
+	appendGroup := func() {
+		if s.groups && digPos > 1 && c.isGroupBoundary(digPos-1) {
+			dst = append(dst, c.GroupSeparator...)
+		}
+	}
+
+	next := 0
+	decimalWritten := false
+	scientific := s.scientific
+	text := s.text
+	for i := 0; i < len(text); i++ {
+		ch := text[i]
+		if adjust > 0 && (ch == '#' || ch == '0' || ch == '.') {
+			for ; adjust > 0; adjust-- {
+				dst = append(dst, d.digit(next))
+				next++
+				appendGroup()
+				digPos--
+			}
+		}
+
+		switch ch {
+		case '#', '0':
+			var digit byte
+			if adjust < 0 {
+				adjust++
+				if digPos <= minIntegerDigits {
+					digit = '0'
+				}
+			} else if next < len(d.digits) {
+				digit = d.digits[next]
+				next++
+			} else if digPos > -minDecimals {
+				digit = '0'
+			}
+			if digit != 0 {
+				dst = append(dst, digit)
+				appendGroup()
+			}
+			digPos--
+		case '.':
+			if digPos != 0 || decimalWritten {
+				break
+			}
+			if minDecimals > 0 || (s.decimalPos < s.digitCount && next < len(d.digits)) {
+				dst = append(dst, c.DecimalSeparator...)
+				decimalWritten = true
+			}
+		case ',':
+		case '%':
+			dst = append(dst, c.PercentSymbol...)
+		case '\'', '"':
+			end := skipQuoted(text, i)
+			dst = append(dst, text[i+1:end]...)
+			i = end
+		case '\\':
+			if i+1 < len(text) {
+				i++
+				dst = append(dst, text[i])
+			}
+		case 'E', 'e':
+			j := i + 1
+			positiveSign := false
+			if j < len(text) && (text[j] == '+' || text[j] == '-') {
+				positiveSign = text[j] == '+'
+				j++
+			}
+			if !scientific || j >= len(text) || text[j] != '0' {
+				dst = append(dst, ch)
+				break
+			}
+			minDigits := 0
+			for ; j < len(text) && text[j] == '0'; j++ {
+				minDigits++
+			}
+			exp := 0
+			if !d.isZero() {
+				exp = d.exp - s.decimalPos
+			}
+			dst = appendExponent(dst, exp, ch, minDigits, positiveSign, c)
+			scientific = false
+			i = j - 1
+		default:
+			if strings.HasPrefix(text[i:], perMille) {
+				dst = append(dst, c.PerMilleSymbol...)
+				i += len(perMille) - 1
+			} else {
+				dst = append(dst, ch)
+			}
+		}
+	}
+
+	return dst
+}
@@ -0,0 +1,91 @@
+package Text
+
+// A single edit which replaced the runes removed at start with inserted,
+// or reversed the whole text. Applying it backwards is its inverse.
+type edit struct {
+	kind     ChangeKind
+	start    int
+	removed  []rune
+	inserted []rune
+	reversed bool
+}
+
+// Reports whether anything keeps track of the edits, so they have to be recorded
+func (s *StringBuilder) tracking() bool {
+	return s.needsText() || len(s.markers) > 0 || s.lines != nil
+}
+
+// Reports whether the removed and inserted text of an edit is needed. Markers only need the lengths.
+func (s *StringBuilder) needsText() bool {
+	return s.recording() || s.journaling() || len(s.observers) > 0
+}
+
+// Hands an edit which is already applied to the content to everything which tracks edits
+func (s *StringBuilder) record(e edit) {
+	if e.reversed {
+		s.invalidateLines(0)
+	} else {
+		s.invalidateLines(e.start)
+	}
+	s.moveMarkers(e.start, len(e.removed), len(e.inserted), e.reversed)
+	s.journalEdit(e)
+	s.recordHistory(e)
+	s.notify(e)
+}
+
+// Records the runes appended behind from. Transactions don't need them, so they are not journaled,
+// and the line index picks them up with its next query.
+func (s *StringBuilder) recordAppend(from int) {
+	if from == s.position || s.batching > 0 {
+		return
+	}
+
+	s.moveMarkers(from, 0, s.position-from, false)
+	if !s.recording() && len(s.observers) == 0 {
+		return
+	}
+
+	inserted := make([]rune, s.position-from)
+	copy(inserted, s.data[from:s.position])
+	e := edit{kind: ChangeAppend, start: from, inserted: inserted}
+	s.recordHistory(e)
+	s.notify(e)
+}
+
+// Starts an append made of several parts which is recorded as a single one by endAppend
+func (s *StringBuilder) beginAppend() int {
+	s.batching++
+
+	return s.position
+}
+
+func (s *StringBuilder) endAppend(from int) {
+	s.batching--
+	s.recordAppend(from)
+}
+
+// Returns a copy of the runes in [start, end) if their removal has to be recorded with the text
+func (s *StringBuilder) captureRemoved(start, end int) []rune {
+	if start == end || !s.needsText() {
+		return nil
+	}
+
+	removed := make([]rune, end-start)
+	copy(removed, s.data[start:end])
+
+	return removed
+}
+
+// Records the removal of the runes in [start, end) after it happened. removed comes from captureRemoved.
+func (s *StringBuilder) recordRemoved(kind ChangeKind, start, end int, removed []rune) {
+	if start == end {
+		return
+	}
+	if removed == nil {
+		s.invalidateLines(start)
+		s.moveMarkers(start, end-start, 0, false)
+		return
+	}
+
+	s.record(edit{kind: kind, start: start, removed: removed})
+}
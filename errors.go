@@ -0,0 +1,91 @@
+package Text
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrIndexOutOfRange is returned if an index or range reaches behind the end of the text
+	ErrIndexOutOfRange = errors.New("index out of range")
+	// ErrNegativeIndex is returned if an index or the start of a range is negative
+	ErrNegativeIndex = errors.New("index can't be negative")
+	// ErrNegativeLength is returned if the length of a range or a count is negative
+	ErrNegativeLength = errors.New("length can't be negative")
+	// ErrInvalidRange is returned if the start of a range lies behind its end
+	ErrInvalidRange = errors.New("start can't be greater than end")
+	// ErrCapacityExceeded is returned if an operation needs more space than the maximum capacity allows
+	ErrCapacityExceeded = errors.New("maximum capacity exceeded")
+	// ErrTransactionClosed is returned when a transaction is used after it was committed or rolled back
+	ErrTransactionClosed = errors.New("transaction has already been committed or rolled back")
+	// ErrSavepointNotFound is returned when rolling back to a savepoint which doesn't exist
+	ErrSavepointNotFound = errors.New("savepoint not found")
+	// ErrSplitRune is returned if a byte or UTF-16 offset points into the middle of a rune
+	ErrSplitRune = errors.New("offset lies inside of a rune")
+)
+
+// RangeError describes a failed bounds check. It wraps one of the sentinel errors,
+// so it can be checked with errors.Is as well as inspected with errors.As.
+type RangeError struct {
+	// Name of the method which failed, e.g. "Remove"
+	Op string
+	// Index or start of the range which was passed
+	Index int
+	// Length of the range which was passed, 0 for single indices
+	Length int
+	// Length of the text at the time of the call
+	Len int
+	// One of ErrIndexOutOfRange, ErrNegativeIndex, ErrNegativeLength, ErrInvalidRange or ErrSplitRune
+	Err error
+}
+
+func (e *RangeError) Error() string {
+	return fmt.Sprintf("%s: %v (index %d, length %d, len %d)", e.Op, e.Err, e.Index, e.Length, e.Len)
+}
+
+func (e *RangeError) Unwrap() error {
+	return e.Err
+}
+
+// Checks that index points at an existing rune. With allowEnd it may also point right behind the last rune,
+// which is where inserts and cursors can go.
+func checkIndex(op string, index int, length int, allowEnd bool) error {
+	if index < 0 {
+		return &RangeError{Op: op, Index: index, Len: length, Err: ErrNegativeIndex}
+	}
+	if index > length || index == length && !allowEnd {
+		return &RangeError{Op: op, Index: index, Len: length, Err: ErrIndexOutOfRange}
+	}
+
+	return nil
+}
+
+// Checks that count runes starting at start lie inside of the text
+func checkRange(op string, start int, count int, length int) error {
+	if start < 0 {
+		return &RangeError{Op: op, Index: start, Length: count, Len: length, Err: ErrNegativeIndex}
+	}
+	if count < 0 {
+		return &RangeError{Op: op, Index: start, Length: count, Len: length, Err: ErrNegativeLength}
+	}
+	if start > length || count > length-start {
+		return &RangeError{Op: op, Index: start, Length: count, Len: length, Err: ErrIndexOutOfRange}
+	}
+
+	return nil
+}
+
+// Checks that the range from start (inclusive) to end (exclusive) lies inside of the text
+func checkBounds(op string, start int, end int, length int) error {
+	if start < 0 {
+		return &RangeError{Op: op, Index: start, Length: end - start, Len: length, Err: ErrNegativeIndex}
+	}
+	if start > end {
+		return &RangeError{Op: op, Index: start, Length: end - start, Len: length, Err: ErrInvalidRange}
+	}
+	if end > length {
+		return &RangeError{Op: op, Index: start, Length: end - start, Len: length, Err: ErrIndexOutOfRange}
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package Text
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestBoundsErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		call    func(s *StringBuilder) error
+		wantErr error
+		want    RangeError
+	}{
+		{"Remove with negative start", func(s *StringBuilder) error { return s.Remove(-1, 1) }, ErrNegativeIndex, RangeError{Op: "Remove", Index: -1, Length: 1, Len: 5}},
+		{"Remove with negative length", func(s *StringBuilder) error { return s.Remove(1, -1) }, ErrNegativeLength, RangeError{Op: "Remove", Index: 1, Length: -1, Len: 5}},
+		{"Remove one behind the end", func(s *StringBuilder) error { return s.Remove(4, 2) }, ErrIndexOutOfRange, RangeError{Op: "Remove", Index: 4, Length: 2, Len: 5}},
+		{"Insert with negative index", func(s *StringBuilder) error { return s.Insert(-1, "a") }, ErrNegativeIndex, RangeError{Op: "Insert", Index: -1, Len: 5}},
+		{"Insert behind the end", func(s *StringBuilder) error { return s.Insert(6, "a") }, ErrIndexOutOfRange, RangeError{Op: "Insert", Index: 6, Len: 5}},
+		{"SetRuneAt at the length", func(s *StringBuilder) error { return s.SetRuneAt(5, 'a') }, ErrIndexOutOfRange, RangeError{Op: "SetRuneAt", Index: 5, Len: 5}},
+		{"SetRuneAt with negative index", func(s *StringBuilder) error { return s.SetRuneAt(-1, 'a') }, ErrNegativeIndex, RangeError{Op: "SetRuneAt", Index: -1, Len: 5}},
+		{"Substring with start behind end", func(s *StringBuilder) error { _, err := s.Substring(3, 1); return err }, ErrInvalidRange, RangeError{Op: "Substring", Index: 3, Length: -2, Len: 5}},
+		{"TryRuneAt at the length", func(s *StringBuilder) error { _, err := s.TryRuneAt(5); return err }, ErrIndexOutOfRange, RangeError{Op: "RuneAt", Index: 5, Len: 5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewStringBuilderFromString("Hello")
+
+			err := tt.call(s)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			var rangeErr *RangeError
+			if !errors.As(err, &rangeErr) {
+				t.Fatalf("error = %v, want a *RangeError", err)
+			}
+			tt.want.Err = tt.wantErr
+			if *rangeErr != tt.want {
+				t.Errorf("RangeError = %+v, want %+v", *rangeErr, tt.want)
+			}
+			if got := s.ToString(); got != "Hello" {
+				t.Errorf("StringBuilder changed to %q after an error", got)
+			}
+		})
+	}
+}
+
+func TestRangeErrorMessage(t *testing.T) {
+	err := NewStringBuilderFromString("Hello").Remove(4, 2)
+
+	want := "Remove: index out of range (index 4, length 2, len 5)"
+	if err == nil || err.Error() != want {
+		t.Errorf("RangeError.Error() = %v, want %v", err, want)
+	}
+}
+
+func TestRemoveUpToTheEnd(t *testing.T) {
+	s := NewStringBuilderFromString("Hello")
+
+	if err := s.Remove(3, 2); err != nil {
+		t.Fatalf("StringBuilder.Remove() threw an error: %v", err)
+	}
+	if err := s.Remove(3, 0); err != nil {
+		t.Fatalf("StringBuilder.Remove() at the end threw an error: %v", err)
+	}
+
+	if got := s.ToString(); got != "Hel" {
+		t.Errorf("StringBuilder.Remove() = %q, want %q", got, "Hel")
+	}
+}
+
+func TestTryRuneAt(t *testing.T) {
+	s := NewStringBuilderFromString("Hällo")
+
+	r, err := s.TryRuneAt(1)
+
+	if err != nil || r != 'ä' {
+		t.Errorf("StringBuilder.TryRuneAt() = %q, %v, want %q, nil", r, err, 'ä')
+	}
+}
+
+func TestRuneAtPanicsWithRangeError(t *testing.T) {
+	// The internal array is larger than the content, so the index has to be checked against the length
+	s := NewStringBuilder(16)
+	s.Append("abc")
+
+	defer func() {
+		err, _ := recover().(error)
+		if !errors.Is(err, ErrIndexOutOfRange) {
+			t.Errorf("StringBuilder.RuneAt() panicked with %v, want %v", err, ErrIndexOutOfRange)
+		}
+	}()
+
+	s.RuneAt(3)
+}
+
+func TestOtherBuildersUseRangeErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		wantErr error
+	}{
+		{"Utf8StringBuilder.Remove", NewUtf8StringBuilderFromString("abc").Remove(2, 2), ErrIndexOutOfRange},
+		{"Utf8StringBuilder.SetRuneAt", NewUtf8StringBuilderFromString("abc").SetRuneAt(-1, 'x'), ErrNegativeIndex},
+		{"Rope.Insert", NewRopeFromString("abc").Insert(4, "x"), ErrIndexOutOfRange},
+		{"Rope.Remove", NewRopeFromString("abc").Remove(0, -1), ErrNegativeLength},
+		{"GapBuffer.MoveTo", NewGapBufferFromString("abc").MoveTo(4), ErrIndexOutOfRange},
+		{"GapBuffer.DeleteBackward", NewGapBufferFromString("abc").DeleteBackward(4), ErrIndexOutOfRange},
+		{"GapBuffer.DeleteForward", NewGapBufferFromString("abc").DeleteForward(-1), ErrNegativeLength},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var rangeErr *RangeError
+			if !errors.Is(tt.err, tt.wantErr) || !errors.As(tt.err, &rangeErr) {
+				t.Errorf("error = %v, want a *RangeError wrapping %v", tt.err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,373 @@
+package Text
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unicode/utf8"
+)
+
+// Maximum number of parsed format strings kept in the cache. Formats beyond that are parsed on every call.
+const maxCachedFormats = 1024
+
+var (
+	formatCache     sync.Map
+	formatCacheSize int64
+)
+
+// FormatError describes a malformed composite format string or an argument that can't be formatted
+type FormatError struct {
+	Format   string
+	Position int
+	Reason   string
+}
+
+func (e *FormatError) Error() string {
+	return fmt.Sprintf("invalid format string %q at position %d: %s", e.Format, e.Position, e.Reason)
+}
+
+// A parsed composite format string
+type compositeFormat struct {
+	items []formatItem
+	// Highest argument index used by any item or -1
+	maxIndex int
+	err      error
+}
+
+// Either a literal text or a placeholder like {0,-10:N2}
+type formatItem struct {
+	literal   string
+	argIndex  int
+	alignment int
+	format    string
+	position  int
+}
+
+// Appends the format string with every format item replaced by the corresponding argument, like
+// String.Format in C#. A format item looks like {index[,alignment][:formatString]}, e.g. {0}, {1,-10} or {2,12:N2}.
+// A positive alignment right-aligns the value, a negative one left-aligns it. Numbers support the .NET
+// standard numeric formats. Use {{ and }} for literal braces.
+// Nothing gets appended if the format string is malformed, refers to a missing argument or the result
+// exceeds the maximum capacity.
+func (s *StringBuilder) AppendFormat(format string, args ...any) error {
+	parsed := parseCompositeFormat(format)
+	if parsed.err != nil {
+		return parsed.err
+	}
+	if parsed.maxIndex >= len(args) {
+		for _, item := range parsed.items {
+			if item.argIndex >= len(args) {
+				return &FormatError{format, item.position, fmt.Sprintf("index %d is out of range, %d arguments given", item.argIndex, len(args))}
+			}
+		}
+	}
+
+	culture := s.Culture()
+	var scratch [64]byte
+	formatted := make([][]byte, 0, len(parsed.items))
+	buffer := scratch[:0]
+	for _, item := range parsed.items {
+		if item.argIndex < 0 {
+			continue
+		}
+		start := len(buffer)
+		var err error
+		buffer, err = appendFormatArgument(buffer, args[item.argIndex], item.format, culture)
+		if err != nil {
+			return &FormatError{format, item.position, err.Error()}
+		}
+		formatted = append(formatted, buffer[start:])
+	}
+
+	// Reserve everything up front, so the result is appended completely or not at all
+	total := 0
+	values := formatted
+	for _, item := range parsed.items {
+		if item.argIndex < 0 {
+			total += utf8.RuneCountInString(item.literal)
+			continue
+		}
+		width := utf8.RuneCount(values[0])
+		values = values[1:]
+		if item.alignment > width {
+			width = item.alignment
+		} else if -item.alignment > width {
+			width = -item.alignment
+		}
+		total += width
+	}
+	if err := s.reserve(total); err != nil {
+		return err
+	}
+
+	from := s.beginAppend()
+	defer s.endAppend(from)
+
+	for _, item := range parsed.items {
+		if item.argIndex < 0 {
+			s.appendString(item.literal)
+			continue
+		}
+
+		value := formatted[0]
+		formatted = formatted[1:]
+		padding := item.alignment
+		if padding < 0 {
+			padding = -padding
+		}
+		padding -= utf8.RuneCount(value)
+
+		if item.alignment > 0 {
+			s.appendPadding(padding)
+		}
+		s.appendUtf8(value)
+		if item.alignment < 0 {
+			s.appendPadding(padding)
+		}
+	}
+
+	return nil
+}
+
+// Sets the culture used to format numbers and dates. nil selects the InvariantCulture.
+func (s *StringBuilder) SetCulture(culture *Culture) *StringBuilder {
+	s.culture = culture
+
+	return s
+}
+
+// Returns the culture used to format numbers and dates
+func (s *StringBuilder) Culture() *Culture {
+	return cultureOrInvariant(s.culture)
+}
+
+// Appends the integer formatted with a .NET standard numeric format like "N2", "X8", "D5", "P", "E3" or "C",
+// or a custom format like "#,##0.00". The symbols come from the culture of the StringBuilder.
+func (s *StringBuilder) AppendInt64(value int64, format string) error {
+	var scratch [64]byte
+	formatted, err := appendFormattedInt(scratch[:0], value, 64, format, s.Culture())
+
+	return s.appendFormatted(formatted, format, err)
+}
+
+// Appends the unsigned integer formatted with a .NET standard or custom numeric format, see AppendInt64
+func (s *StringBuilder) AppendUint64(value uint64, format string) error {
+	var scratch [64]byte
+	formatted, err := appendFormattedUint(scratch[:0], value, format, s.Culture())
+
+	return s.appendFormatted(formatted, format, err)
+}
+
+// Appends the floating-point number formatted with a .NET standard or custom numeric format, see AppendInt64.
+// Hexadecimal and decimal formats are not supported for floating-point numbers.
+func (s *StringBuilder) AppendFloat(value float64, format string) error {
+	var scratch [64]byte
+	formatted, err := appendFormattedFloat(scratch[:0], value, 64, format, s.Culture())
+
+	return s.appendFormatted(formatted, format, err)
+}
+
+// Appends the time formatted with a .NET standard date and time format like "d", "D", "g", "O" or "u",
+// or a custom format like "dd.MM.yyyy HH:mm". Names and patterns come from the culture of the StringBuilder.
+func (s *StringBuilder) AppendTime(value time.Time, format string) error {
+	var scratch [64]byte
+	formatted, err := appendFormattedTime(scratch[:0], value, format, s.Culture())
+
+	return s.appendFormatted(formatted, format, err)
+}
+
+func (s *StringBuilder) appendFormatted(formatted []byte, format string, err error) error {
+	if err != nil {
+		return &FormatError{format, 0, err.Error()}
+	}
+
+	return s.appendUtf8(formatted)
+}
+
+func (s *StringBuilder) appendPadding(count int) {
+	for i := 0; i < count; i++ {
+		s.appendRune(' ')
+	}
+}
+
+// Appends the argument formatted with the given format string
+func appendFormatArgument(dst []byte, arg any, format string, c *Culture) ([]byte, error) {
+	switch v := arg.(type) {
+	case nil:
+		return dst, nil
+	case string:
+		return append(dst, v...), nil
+	case int:
+		return appendFormattedInt(dst, int64(v), strconv.IntSize, format, c)
+	case int8:
+		return appendFormattedInt(dst, int64(v), 8, format, c)
+	case int16:
+		return appendFormattedInt(dst, int64(v), 16, format, c)
+	case int32:
+		return appendFormattedInt(dst, int64(v), 32, format, c)
+	case int64:
+		return appendFormattedInt(dst, v, 64, format, c)
+	case uint:
+		return appendFormattedUint(dst, uint64(v), format, c)
+	case uint8:
+		return appendFormattedUint(dst, uint64(v), format, c)
+	case uint16:
+		return appendFormattedUint(dst, uint64(v), format, c)
+	case uint32:
+		return appendFormattedUint(dst, uint64(v), format, c)
+	case uint64:
+		return appendFormattedUint(dst, v, format, c)
+	case uintptr:
+		return appendFormattedUint(dst, uint64(v), format, c)
+	case float32:
+		return appendFormattedFloat(dst, float64(v), 32, format, c)
+	case float64:
+		return appendFormattedFloat(dst, v, 64, format, c)
+	case time.Time:
+		return appendFormattedTime(dst, v, format, c)
+	case fmt.Stringer:
+		return append(dst, v.String()...), nil
+	case error:
+		return append(dst, v.Error()...), nil
+	}
+
+	return fmt.Append(dst, arg), nil
+}
+
+// Returns the parsed format from the cache or parses and caches it
+func parseCompositeFormat(format string) *compositeFormat {
+	if cached, ok := formatCache.Load(format); ok {
+		return cached.(*compositeFormat)
+	}
+
+	parsed := parseFormat(format)
+	if atomic.LoadInt64(&formatCacheSize) < maxCachedFormats {
+		if _, loaded := formatCache.LoadOrStore(format, parsed); !loaded {
+			atomic.AddInt64(&formatCacheSize, 1)
+		}
+	}
+
+	return parsed
+}
+
+func parseFormat(format string) *compositeFormat {
+	parsed := &compositeFormat{maxIndex: -1}
+	fail := func(position int, reason string) *compositeFormat {
+		return &compositeFormat{err: &FormatError{format, position, reason}}
+	}
+
+	literal := make([]byte, 0, len(format))
+	for i := 0; i < len(format); i++ {
+		c := format[i]
+		if c == '}' {
+			if i+1 < len(format) && format[i+1] == '}' {
+				literal = append(literal, '}')
+				i++
+				continue
+			}
+			return fail(i, "unexpected '}', use '}}' for a literal brace")
+		}
+		if c != '{' {
+			literal = append(literal, c)
+			continue
+		}
+		if i+1 < len(format) && format[i+1] == '{' {
+			literal = append(literal, '{')
+			i++
+			continue
+		}
+
+		if len(literal) > 0 {
+			parsed.items = append(parsed.items, formatItem{literal: string(literal), argIndex: -1})
+			literal = literal[:0]
+		}
+
+		item, next, err := parseFormatItem(format, i)
+		if err != "" {
+			return fail(next, err)
+		}
+		if item.argIndex > parsed.maxIndex {
+			parsed.maxIndex = item.argIndex
+		}
+		parsed.items = append(parsed.items, item)
+		i = next
+	}
+
+	if len(literal) > 0 {
+		parsed.items = append(parsed.items, formatItem{literal: string(literal), argIndex: -1})
+	}
+
+	return parsed
+}
+
+// Parses the format item starting with the '{' at start. Returns the item and the position of its closing brace,
+// or the position and description of the error.
+func parseFormatItem(format string, start int) (formatItem, int, string) {
+	item := formatItem{position: start}
+	i := start + 1
+
+	digits := 0
+	for ; i < len(format) && format[i] >= '0' && format[i] <= '9'; i++ {
+		item.argIndex = item.argIndex*10 + int(format[i]-'0')
+		digits++
+		if item.argIndex > 1_000_000 {
+			return item, i, "index is too large"
+		}
+	}
+	if digits == 0 {
+		return item, i, "expected an argument index"
+	}
+	i = skipSpaces(format, i)
+
+	if i < len(format) && format[i] == ',' {
+		i = skipSpaces(format, i+1)
+		negative := i < len(format) && format[i] == '-'
+		if negative {
+			i++
+		}
+		digits = 0
+		for ; i < len(format) && format[i] >= '0' && format[i] <= '9'; i++ {
+			item.alignment = item.alignment*10 + int(format[i]-'0')
+			digits++
+			if item.alignment > 1_000_000 {
+				return item, i, "alignment is too large"
+			}
+		}
+		if digits == 0 {
+			return item, i, "expected an alignment"
+		}
+		if negative {
+			item.alignment = -item.alignment
+		}
+		i = skipSpaces(format, i)
+	}
+
+	if i < len(format) && format[i] == ':' {
+		formatStart := i + 1
+		for i++; i < len(format) && format[i] != '}'; i++ {
+			if format[i] == '{' {
+				return item, i, "unexpected '{' inside of a format item"
+			}
+		}
+		item.format = format[formatStart:i]
+	}
+
+	if i >= len(format) {
+		return item, start, "format item is not closed"
+	}
+	if format[i] != '}' {
+		return item, i, fmt.Sprintf("unexpected %q inside of a format item", format[i])
+	}
+
+	return item, i, ""
+}
+
+func skipSpaces(format string, i int) int {
+	for i < len(format) && format[i] == ' ' {
+		i++
+	}
+
+	return i
+}
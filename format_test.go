@@ -0,0 +1,169 @@
+package Text
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestAppendFormat(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		args   []any
+		want   string
+	}{
+		{"No format items", "Hello World", nil, "Hello World"},
+		{"Single argument", "Hello {0}", []any{"World"}, "Hello World"},
+		{"Argument used twice", "{0}{1}{0}", []any{"a", "b"}, "aba"},
+		{"Right aligned", "[{0,5}]", []any{"ab"}, "[   ab]"},
+		{"Left aligned", "[{0,-5}]", []any{"ab"}, "[ab   ]"},
+		{"Alignment shorter than value", "[{0,2}]", []any{"abcd"}, "[abcd]"},
+		{"Alignment counts runes", "[{0,4}]", []any{"öü"}, "[  öü]"},
+		{"Hexadecimal", "{0:X8}", []any{255}, "000000FF"},
+		{"Lowercase hexadecimal", "{0:x}", []any{255}, "ff"},
+		{"Negative hexadecimal", "{0:X}", []any{int8(-1)}, "FF"},
+		{"Number with alignment", "[{0,12:N2}]", []any{1234.567}, "[    1,234.57]"},
+		{"Escaped braces", "{{{0}}}", []any{1}, "{1}"},
+		{"Nil argument", "[{0}]", []any{nil}, "[]"},
+		{"Boolean", "{0}", []any{true}, "true"},
+		{"Error", "{0}", []any{errors.New("failed")}, "failed"},
+		{"Stringer", "{0}", []any{stringer("value")}, "value"},
+		{"Other types", "{0}", []any{[]int{1, 2}}, "[1 2]"},
+		{"Spaces around alignment", "[{0 , -3 }]", []any{1}, "[1  ]"},
+		{"Multibyte literal", "Größe: {0}", []any{5}, "Größe: 5"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &StringBuilder{}
+			if err := s.AppendFormat(tt.format, tt.args...); err != nil {
+				t.Fatalf("StringBuilder.AppendFormat() threw an error: %v", err)
+			}
+			if got := s.ToString(); got != tt.want {
+				t.Errorf("StringBuilder.AppendFormat() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAppendFormatErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   string
+		args     []any
+		position int
+	}{
+		{"Unescaped closing brace", "ab}", nil, 2},
+		{"Unclosed format item", "ab{0", []any{1}, 2},
+		{"Missing index", "{}", nil, 1},
+		{"Index out of range", "{0} {1}", []any{1}, 4},
+		{"Missing alignment", "{0,}", []any{1}, 3},
+		{"Invalid character", "{0;}", []any{1}, 2},
+		{"Invalid numeric format", "{0:Z}", []any{1}, 0},
+		{"Decimal format for float", "{0:D2}", []any{1.5}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewStringBuilderFromString("x")
+
+			err := s.AppendFormat(tt.format, tt.args...)
+
+			var formatErr *FormatError
+			if !errors.As(err, &formatErr) {
+				t.Fatalf("StringBuilder.AppendFormat() error = %v, want a *FormatError", err)
+			}
+			if formatErr.Position != tt.position {
+				t.Errorf("FormatError.Position = %v, want %v", formatErr.Position, tt.position)
+			}
+			if got := s.ToString(); got != "x" {
+				t.Errorf("StringBuilder.AppendFormat() appended %q after an error", got)
+			}
+		})
+	}
+}
+
+func TestAppendFormatUsesCachedFormat(t *testing.T) {
+	first := parseCompositeFormat("cached {0}")
+	second := parseCompositeFormat("cached {0}")
+
+	if first != second {
+		t.Errorf("parseCompositeFormat() parsed the same format twice")
+	}
+}
+
+func TestFormatErrorMessage(t *testing.T) {
+	err := (&StringBuilder{}).AppendFormat("a}")
+
+	want := `invalid format string "a}" at position 1: unexpected '}', use '}}' for a literal brace`
+	if err == nil || err.Error() != want {
+		t.Errorf("FormatError.Error() = %v, want %v", err, want)
+	}
+}
+
+type stringer string
+
+func (s stringer) String() string {
+	return string(s)
+}
+
+var _ fmt.Stringer = stringer("")
+
+func TestAppendNumbersAndTime(t *testing.T) {
+	s := NewStringBuilder(64).SetCulture(CultureEnUS)
+
+	s.AppendInt64(-42, "D5")
+	s.AppendRune(' ')
+	s.AppendUint64(3000000000, "N0")
+	s.AppendRune(' ')
+	s.AppendFloat(1234.5, "C")
+	s.AppendRune(' ')
+	s.AppendTime(time.Date(2024, 3, 5, 14, 7, 0, 0, time.UTC), "t")
+
+	want := "-00042 3,000,000,000 $1,234.50 2:07 PM"
+	if got := s.ToString(); got != want {
+		t.Errorf("StringBuilder.Append... = %q, want %q", got, want)
+	}
+}
+
+func TestAppendFormatUsesCulture(t *testing.T) {
+	s := NewStringBuilder(0).SetCulture(CultureDeDE)
+
+	s.AppendFormat("{0:N2} | {1:d}", 1234.5, time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC))
+
+	if got := s.ToString(); got != "1.234,50 | 05.03.2024" {
+		t.Errorf("StringBuilder.AppendFormat() = %q, want %q", got, "1.234,50 | 05.03.2024")
+	}
+}
+
+func TestAppendNumberWithInvalidFormat(t *testing.T) {
+	s := NewStringBuilderFromString("x")
+
+	err := s.AppendFloat(1.5, "D2")
+
+	var formatErr *FormatError
+	if !errors.As(err, &formatErr) {
+		t.Errorf("StringBuilder.AppendFloat() error = %v, want a *FormatError", err)
+	}
+	if got := s.ToString(); got != "x" {
+		t.Errorf("StringBuilder.AppendFloat() appended %q after an error", got)
+	}
+}
+
+func TestAppendNumbersDoNotAllocate(t *testing.T) {
+	s := NewStringBuilder(256)
+	now := time.Date(2024, 3, 5, 14, 7, 9, 0, time.UTC)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		s.Clear()
+		s.AppendInt64(-1234567, "N2")
+		s.AppendUint64(255, "X8")
+		s.AppendFloat(0.1234, "P1")
+		s.AppendFloat(1234.567, "#,##0.00")
+		s.AppendTime(now, "yyyy-MM-dd HH:mm:ss")
+	})
+
+	if allocs != 0 {
+		t.Errorf("Formatted appends allocated %v times, want 0", allocs)
+	}
+}
@@ -0,0 +1,278 @@
+package Text
+
+// GapBuffer is a text buffer optimised for edits around a moving cursor, like in editors or line editors.
+// The free space of the buffer (the gap) is kept at the position of the last edit, so inserting and deleting
+// next to it only costs the size of the edit instead of a shift of the whole tail.
+// Besides the cursor API it offers the same read API as StringBuilder.
+type GapBuffer struct {
+	data     []rune
+	gapStart int
+	gapEnd   int
+	cursor   int
+}
+
+// Creates a new instance of the GapBuffer with preallocated space for initialCapacity runes
+func NewGapBuffer(initialCapacity int) *GapBuffer {
+	return &GapBuffer{data: make([]rune, initialCapacity), gapEnd: initialCapacity}
+}
+
+// Creates a new instance of the GapBuffer with a preallocated text. The cursor is placed at the end.
+func NewGapBufferFromString(text string) *GapBuffer {
+	textRunes := []rune(text)
+	return &GapBuffer{
+		data:     textRunes,
+		gapStart: len(textRunes),
+		gapEnd:   len(textRunes),
+		cursor:   len(textRunes),
+	}
+}
+
+// Returns the current position of the cursor
+func (s *GapBuffer) Cursor() int {
+	return s.cursor
+}
+
+// Moves the cursor to the given index. The index can be anything from 0 to Len()
+func (s *GapBuffer) MoveTo(index int) error {
+	if err := checkIndex("MoveTo", index, s.Len(), true); err != nil {
+		return err
+	}
+
+	s.cursor = index
+
+	return nil
+}
+
+// Inserts the text at the cursor and moves the cursor behind the inserted text
+func (s *GapBuffer) InsertAtCursor(text string) *GapBuffer {
+	runeText := []rune(text)
+	s.insertRunes(s.cursor, runeText)
+	s.cursor += len(runeText)
+
+	return s
+}
+
+// Deletes count runes in front of the cursor, like a backspace key does. The cursor moves with the deletion.
+func (s *GapBuffer) DeleteBackward(count int) error {
+	if count < 0 {
+		return &RangeError{Op: "DeleteBackward", Index: s.cursor, Length: count, Len: s.Len(), Err: ErrNegativeLength}
+	}
+	if count > s.cursor {
+		return &RangeError{Op: "DeleteBackward", Index: s.cursor, Length: count, Len: s.Len(), Err: ErrIndexOutOfRange}
+	}
+
+	s.moveGap(s.cursor)
+	s.gapStart -= count
+	s.cursor -= count
+
+	return nil
+}
+
+// Deletes count runes behind the cursor, like a delete key does. The cursor stays where it is.
+func (s *GapBuffer) DeleteForward(count int) error {
+	if err := checkRange("DeleteForward", s.cursor, count, s.Len()); err != nil {
+		return err
+	}
+
+	s.moveGap(s.cursor)
+	s.gapEnd += count
+
+	return nil
+}
+
+// Appends a text to the end of the GapBuffer instance. The cursor stays where it is.
+func (s *GapBuffer) Append(text string) *GapBuffer {
+	s.insertRunes(s.Len(), []rune(text))
+
+	return s
+}
+
+// Appends a text and a new line character to the GapBuffer instance
+func (s *GapBuffer) AppendLine(text string) *GapBuffer {
+	s.Append(text)
+	s.Append("\n")
+
+	return s
+}
+
+// Appends a single character to the GapBuffer instance
+func (s *GapBuffer) AppendRune(char rune) *GapBuffer {
+	s.insertRunes(s.Len(), []rune{char})
+
+	return s
+}
+
+// Inserts the text at the given index. A cursor behind the index moves along with its text.
+func (s *GapBuffer) Insert(index int, text string) error {
+	if err := checkIndex("Insert", index, s.Len(), true); err != nil {
+		return err
+	}
+
+	runeText := []rune(text)
+	s.insertRunes(index, runeText)
+	if index < s.cursor {
+		s.cursor += len(runeText)
+	}
+
+	return nil
+}
+
+// Removes length runes starting at start. A cursor inside the removed range moves to start.
+func (s *GapBuffer) Remove(start int, length int) error {
+	if err := checkRange("Remove", start, length, s.Len()); err != nil {
+		return err
+	}
+
+	s.moveGap(start)
+	s.gapEnd += length
+
+	if s.cursor >= start+length {
+		s.cursor -= length
+	} else if s.cursor > start {
+		s.cursor = start
+	}
+
+	return nil
+}
+
+// Removes all characters from the current instance and moves the cursor to the start.
+// The internal array will stay the same.
+func (s *GapBuffer) Clear() {
+	s.gapStart = 0
+	s.gapEnd = len(s.data)
+	s.cursor = 0
+}
+
+// Returns the current length of the represented string
+func (s *GapBuffer) Len() int {
+	return len(s.data) - (s.gapEnd - s.gapStart)
+}
+
+// Returns the represented string
+func (s *GapBuffer) ToString() string {
+	r := make([]rune, 0, s.Len())
+	r = append(r, s.data[:s.gapStart]...)
+	r = append(r, s.data[s.gapEnd:]...)
+
+	return string(r)
+}
+
+// Gets the rune at the specific position. Panics with a *RangeError if the index is out of range.
+func (s *GapBuffer) RuneAt(index int) rune {
+	r, err := s.TryRuneAt(index)
+	if err != nil {
+		panic(err)
+	}
+
+	return r
+}
+
+// Gets the rune at the specific position or returns a *RangeError if the index is out of range
+func (s *GapBuffer) TryRuneAt(index int) (rune, error) {
+	if err := checkIndex("RuneAt", index, s.Len(), false); err != nil {
+		return 0, err
+	}
+	if index < s.gapStart {
+		return s.data[index], nil
+	}
+
+	return s.data[index+s.gapEnd-s.gapStart], nil
+}
+
+// Returns a substring from start (inclusive) to end (exclusive).
+func (s *GapBuffer) Substring(start, end int) (string, error) {
+	if err := checkBounds("Substring", start, end, s.Len()); err != nil {
+		return "", err
+	}
+
+	r := make([]rune, 0, end-start)
+	if start < s.gapStart {
+		beforeGap := end
+		if beforeGap > s.gapStart {
+			beforeGap = s.gapStart
+		}
+		r = append(r, s.data[start:beforeGap]...)
+	}
+	if end > s.gapStart {
+		afterGap := start
+		if afterGap < s.gapStart {
+			afterGap = s.gapStart
+		}
+		gapLen := s.gapEnd - s.gapStart
+		r = append(r, s.data[afterGap+gapLen:end+gapLen]...)
+	}
+
+	return string(r), nil
+}
+
+// Returns the first occurrence of the given text in the gap buffer. Returns -1 if not found
+func (s *GapBuffer) FindFirst(text string, opts ...SearchOption) int {
+	return findFirst(s.contiguous(), text, newSearchOptions(opts))
+}
+
+// Returns the last occurrence of the given text in the gap buffer. Returns -1 if not found
+func (s *GapBuffer) FindLast(text string, opts ...SearchOption) int {
+	return findLast(s.contiguous(), text, newSearchOptions(opts))
+}
+
+// Returns all occurrences of the given text in the gap buffer. Returns an empty if no occurrence found.
+func (s *GapBuffer) FindAll(text string, opts ...SearchOption) []int {
+	return findAll(s.contiguous(), text, newSearchOptions(opts))
+}
+
+// Moves the gap behind the text and returns the content as one slice.
+// The next edit moves the gap back to where it is needed.
+func (s *GapBuffer) contiguous() []rune {
+	s.moveGap(s.Len())
+
+	return s.data[:s.gapStart]
+}
+
+func (s *GapBuffer) insertRunes(index int, runes []rune) {
+	if len(runes) == 0 {
+		return
+	}
+
+	s.moveGap(index)
+	s.ensureGap(len(runes))
+	copy(s.data[s.gapStart:], runes)
+	s.gapStart += len(runes)
+}
+
+// Moves the gap so it starts at the given index
+func (s *GapBuffer) moveGap(index int) {
+	if index < s.gapStart {
+		moved := s.gapStart - index
+		copy(s.data[s.gapEnd-moved:s.gapEnd], s.data[index:s.gapStart])
+		s.gapStart -= moved
+		s.gapEnd -= moved
+	} else if index > s.gapStart {
+		moved := index - s.gapStart
+		copy(s.data[s.gapStart:], s.data[s.gapEnd:s.gapEnd+moved])
+		s.gapStart += moved
+		s.gapEnd += moved
+	}
+}
+
+// Grows the buffer until the gap can take at least size runes
+func (s *GapBuffer) ensureGap(size int) {
+	if s.gapEnd-s.gapStart >= size {
+		return
+	}
+
+	required := s.Len() + size
+	newLen := len(s.data) * 2
+	if newLen == 0 {
+		newLen = 8
+	}
+	for newLen < required {
+		newLen *= 2
+	}
+
+	data := make([]rune, newLen)
+	copy(data, s.data[:s.gapStart])
+	tail := len(s.data) - s.gapEnd
+	copy(data[newLen-tail:], s.data[s.gapEnd:])
+	s.data = data
+	s.gapEnd = newLen - tail
+}
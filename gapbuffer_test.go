@@ -0,0 +1,135 @@
+package Text
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestGapBufferCursorEditing(t *testing.T) {
+	g := NewGapBufferFromString("Hello World")
+
+	if err := g.MoveTo(5); err != nil {
+		t.Errorf("MoveTo threw an error: %v", err)
+	}
+	g.InsertAtCursor(" my").InsertAtCursor(" dear")
+	if err := g.DeleteBackward(5); err != nil {
+		t.Errorf("DeleteBackward threw an error: %v", err)
+	}
+	if err := g.DeleteForward(3); err != nil {
+		t.Errorf("DeleteForward threw an error: %v", err)
+	}
+	g.InsertAtCursor(" Wö")
+
+	if got := g.ToString(); got != "Hello my Wörld" {
+		t.Errorf("GapBuffer.ToString() = %q, want %q", got, "Hello my Wörld")
+	}
+	if got := g.Cursor(); got != 11 {
+		t.Errorf("GapBuffer.Cursor() = %v, want %v", got, 11)
+	}
+}
+
+func TestGapBufferCursorErrors(t *testing.T) {
+	g := NewGapBufferFromString("Hello")
+
+	if err := g.MoveTo(6); err == nil {
+		t.Error("MoveTo should throw error but did not")
+	}
+	if err := g.MoveTo(-1); err == nil {
+		t.Error("MoveTo should throw error but did not")
+	}
+	g.MoveTo(2)
+	if err := g.DeleteBackward(3); err == nil {
+		t.Error("DeleteBackward should throw error but did not")
+	}
+	if err := g.DeleteForward(4); err == nil {
+		t.Error("DeleteForward should throw error but did not")
+	}
+}
+
+func TestGapBufferInsertAndRemoveMoveCursor(t *testing.T) {
+	g := NewGapBufferFromString("Hello World")
+	g.MoveTo(6)
+
+	g.Insert(0, ">> ")
+	g.Remove(2, 1)
+	g.Append("!")
+
+	if got := g.ToString(); got != ">>Hello World!" {
+		t.Errorf("GapBuffer.ToString() = %q, want %q", got, ">>Hello World!")
+	}
+	if got := g.Cursor(); got != 8 {
+		t.Errorf("GapBuffer.Cursor() = %v, want %v", got, 8)
+	}
+
+	g.Remove(5, 5)
+
+	if got := g.Cursor(); got != 5 {
+		t.Errorf("GapBuffer.Cursor() = %v, want %v", got, 5)
+	}
+}
+
+func TestGapBufferReadApi(t *testing.T) {
+	g := NewGapBuffer(4)
+	g.AppendLine("Hällo").Append("Hällo")
+	g.MoveTo(3)
+	g.InsertAtCursor("xx")
+
+	if got := g.Len(); got != 13 {
+		t.Errorf("GapBuffer.Len() = %v, want %v", got, 13)
+	}
+	if got := g.RuneAt(5); got != 'l' {
+		t.Errorf("GapBuffer.RuneAt() = %q, want %q", got, 'l')
+	}
+	if got, _ := g.Substring(2, 6); got != "lxxl" {
+		t.Errorf("GapBuffer.Substring() = %q, want %q", got, "lxxl")
+	}
+	if got := g.FindAll("llo"); !slicesEqual(got, []int{10}) {
+		t.Errorf("GapBuffer.FindAll() = %v, want %v", got, []int{10})
+	}
+	if got := g.FindLast("Hä"); got != 8 {
+		t.Errorf("GapBuffer.FindLast() = %v, want %v", got, 8)
+	}
+	if _, err := g.Substring(2, 14); err == nil {
+		t.Error("Substring should throw error but did not")
+	}
+
+	g.InsertAtCursor("!")
+
+	if got := g.ToString(); got != "Hälxx!lo\nHällo" {
+		t.Errorf("GapBuffer.ToString() = %q, want %q", got, "Hälxx!lo\nHällo")
+	}
+}
+
+func TestGapBufferMatchesStringBuilderAfterRandomEdits(t *testing.T) {
+	random := rand.New(rand.NewSource(7))
+	g := NewGapBuffer(0)
+	sb := &StringBuilder{}
+
+	for i := 0; i < 3000; i++ {
+		index := random.Intn(sb.Len() + 1)
+		switch random.Intn(4) {
+		case 0:
+			g.MoveTo(index)
+			g.InsertAtCursor("äb")
+			sb.Insert(index, "äb")
+		case 1:
+			g.MoveTo(index)
+			count := random.Intn(index + 1)
+			g.DeleteBackward(count)
+			sb.Remove(index-count, count)
+		case 2:
+			g.Insert(index, "c")
+			sb.Insert(index, "c")
+		default:
+			g.Append("de")
+			sb.Append("de")
+		}
+	}
+
+	if got, want := g.ToString(), sb.ToString(); got != want {
+		t.Fatalf("GapBuffer diverged from StringBuilder")
+	}
+	if got, want := g.FindFirst("bcd"), sb.FindFirst("bcd"); got != want {
+		t.Errorf("GapBuffer.FindFirst() = %v, want %v", got, want)
+	}
+}
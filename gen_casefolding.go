@@ -0,0 +1,123 @@
+//go:build ignore
+
+// Generates casefoldingtables.go from the Unicode Character Database.
+// Download CaseFolding.txt of the wanted version into a directory and run
+//
+//	go run gen_casefolding.go -ucd <directory> -version 15.0.0
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+func main() {
+	ucd := flag.String("ucd", "ucd", "directory with the files of the Unicode Character Database")
+	version := flag.String("version", "", "version of the Unicode Character Database")
+	flag.Parse()
+
+	mappings := map[string]map[rune][]rune{"C": {}, "F": {}, "S": {}, "T": {}}
+	forEachLine(filepath.Join(*ucd, "CaseFolding.txt"), func(line string) {
+		fields := strings.Split(line, ";")
+		if len(fields) < 3 {
+			log.Fatalf("CaseFolding.txt: invalid line %q", line)
+		}
+		status := strings.TrimSpace(fields[1])
+		if _, ok := mappings[status]; !ok {
+			log.Fatalf("CaseFolding.txt: unknown status %q", status)
+		}
+		var mapping []rune
+		for _, code := range strings.Fields(fields[2]) {
+			mapping = append(mapping, parseCodePoint(code))
+		}
+		mappings[status][parseCodePoint(strings.TrimSpace(fields[0]))] = mapping
+	})
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by gen_casefolding.go from the Unicode Character Database %s. DO NOT EDIT.\n\n", *version)
+	fmt.Fprintf(&b, "package Text\n\n")
+	fmt.Fprintf(&b, "// Simple case foldings of CaseFolding.txt, the entries with the status C and S.\n")
+	fmt.Fprintf(&b, "// See https://www.unicode.org/license.html for the Unicode license agreement.\n")
+	fmt.Fprintf(&b, "var simpleCaseFoldings = map[rune]rune{\n")
+	simple := map[rune][]rune{}
+	for r, m := range mappings["C"] {
+		simple[r] = m
+	}
+	for r, m := range mappings["S"] {
+		simple[r] = m
+	}
+	for _, r := range sortedKeys(simple) {
+		fmt.Fprintf(&b, "\t0x%04X: 0x%04X,\n", r, simple[r][0])
+	}
+	fmt.Fprintf(&b, "}\n\n")
+
+	fmt.Fprintf(&b, "// Full case foldings which map to several runes, the entries with the status F\n")
+	fmt.Fprintf(&b, "var fullCaseFoldings = map[rune]string{\n")
+	for _, r := range sortedKeys(mappings["F"]) {
+		fmt.Fprintf(&b, "\t0x%04X: %s,\n", r, strconv.QuoteToASCII(string(mappings["F"][r])))
+	}
+	fmt.Fprintf(&b, "}\n\n")
+
+	fmt.Fprintf(&b, "// Case foldings for Turkic languages, the entries with the status T\n")
+	fmt.Fprintf(&b, "var turkicCaseFoldings = map[rune]rune{\n")
+	for _, r := range sortedKeys(mappings["T"]) {
+		fmt.Fprintf(&b, "\t0x%04X: 0x%04X,\n", r, mappings["T"][r][0])
+	}
+	fmt.Fprintf(&b, "}\n")
+
+	formatted, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("casefoldingtables.go", formatted, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func sortedKeys(m map[rune][]rune) []rune {
+	keys := make([]rune, 0, len(m))
+	for r := range m {
+		keys = append(keys, r)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+	return keys
+}
+
+// Calls fn with the part in front of the comment of every line which isn't empty
+func forEachLine(path string, fn func(line string)) {
+	file, err := os.Open(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		if strings.TrimSpace(line) != "" {
+			fn(line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func parseCodePoint(hex string) rune {
+	code, err := strconv.ParseInt(hex, 16, 32)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return rune(code)
+}
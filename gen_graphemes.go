@@ -0,0 +1,173 @@
+//go:build ignore
+
+// Generates graphemetables.go and graphemebreak_test.go from the Unicode Character Database.
+// Download auxiliary/GraphemeBreakProperty.txt, auxiliary/GraphemeBreakTest.txt and emoji/emoji-data.txt
+// of the wanted version into a directory and run
+//
+//	go run gen_graphemes.go -ucd <directory> -version 15.0.0
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var properties = map[string]string{
+	"CR":                    "gbCR",
+	"LF":                    "gbLF",
+	"Control":               "gbControl",
+	"Extend":                "gbExtend",
+	"ZWJ":                   "gbZWJ",
+	"Regional_Indicator":    "gbRegionalIndicator",
+	"Prepend":               "gbPrepend",
+	"SpacingMark":           "gbSpacingMark",
+	"L":                     "gbL",
+	"V":                     "gbV",
+	"T":                     "gbT",
+	"LV":                    "gbLV",
+	"LVT":                   "gbLVT",
+	"Extended_Pictographic": "gbExtendedPictographic",
+}
+
+type codeRange struct {
+	lo, hi   int
+	property string
+}
+
+func main() {
+	ucd := flag.String("ucd", "ucd", "directory with the files of the Unicode Character Database")
+	version := flag.String("version", "", "version of the Unicode Character Database")
+	flag.Parse()
+
+	ranges := parseProperties(filepath.Join(*ucd, "auxiliary", "GraphemeBreakProperty.txt"), "")
+	ranges = append(ranges, parseProperties(filepath.Join(*ucd, "emoji", "emoji-data.txt"), "Extended_Pictographic")...)
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].lo < ranges[j].lo })
+
+	var merged []codeRange
+	for _, r := range ranges {
+		if n := len(merged); n > 0 {
+			last := &merged[n-1]
+			if r.lo <= last.hi {
+				log.Fatalf("%04X..%04X is %s and %s", r.lo, r.hi, last.property, r.property)
+			}
+			if r.lo == last.hi+1 && r.property == last.property {
+				last.hi = r.hi
+				continue
+			}
+		}
+		merged = append(merged, r)
+	}
+
+	var table bytes.Buffer
+	fmt.Fprintf(&table, "// Code generated by gen_graphemes.go from the Unicode Character Database %s. DO NOT EDIT.\n\n", *version)
+	fmt.Fprintf(&table, "package Text\n\n")
+	fmt.Fprintf(&table, "// Grapheme cluster break properties of auxiliary/GraphemeBreakProperty.txt and Extended_Pictographic\n")
+	fmt.Fprintf(&table, "// of emoji/emoji-data.txt, sorted by code point. Code points which are not listed are gbOther.\n")
+	fmt.Fprintf(&table, "// See https://www.unicode.org/license.html for the Unicode license agreement.\n")
+	fmt.Fprintf(&table, "var graphemeBreakTable = []graphemeRange{\n")
+	for _, r := range merged {
+		fmt.Fprintf(&table, "\t{0x%04X, 0x%04X, %s},\n", r.lo, r.hi, r.property)
+	}
+	fmt.Fprintf(&table, "}\n")
+	write("graphemetables.go", table.Bytes())
+
+	var tests bytes.Buffer
+	fmt.Fprintf(&tests, "// Code generated by gen_graphemes.go from the Unicode Character Database %s. DO NOT EDIT.\n\n", *version)
+	fmt.Fprintf(&tests, "package Text\n\n")
+	fmt.Fprintf(&tests, "// Test cases of auxiliary/GraphemeBreakTest.txt: a text and its grapheme clusters.\n")
+	fmt.Fprintf(&tests, "// See https://www.unicode.org/license.html for the Unicode license agreement.\n")
+	fmt.Fprintf(&tests, "var graphemeBreakTests = []struct {\n\ttext     string\n\tclusters []string\n}{\n")
+	forEachLine(filepath.Join(*ucd, "auxiliary", "GraphemeBreakTest.txt"), func(fields string) {
+		var text string
+		var clusters []string
+		for _, field := range strings.Fields(fields) {
+			switch field {
+			case "÷":
+				clusters = append(clusters, "")
+			case "×":
+			default:
+				code := fmt.Sprintf("\\U%08X", parseCodePoint(field))
+				text += code
+				clusters[len(clusters)-1] += code
+			}
+		}
+		// Drop the empty cluster behind the final break
+		clusters = clusters[:len(clusters)-1]
+		fmt.Fprintf(&tests, "\t{\"%s\", []string{\"%s\"}},\n", text, strings.Join(clusters, "\", \""))
+	})
+	fmt.Fprintf(&tests, "}\n")
+	write("graphemebreak_test.go", tests.Bytes())
+}
+
+// Reads lines like "0600..0605 ; Prepend # ...". If only is set, only that property is read.
+func parseProperties(path string, only string) []codeRange {
+	var ranges []codeRange
+	forEachLine(path, func(fields string) {
+		parts := strings.Split(fields, ";")
+		if len(parts) != 2 {
+			log.Fatalf("%s: invalid line %q", path, fields)
+		}
+		name := strings.TrimSpace(parts[1])
+		if only != "" && name != only {
+			return
+		}
+		property, ok := properties[name]
+		if !ok {
+			log.Fatalf("%s: unknown property %q", path, name)
+		}
+
+		codes := strings.Split(strings.TrimSpace(parts[0]), "..")
+		r := codeRange{parseCodePoint(codes[0]), parseCodePoint(codes[len(codes)-1]), property}
+		ranges = append(ranges, r)
+	})
+
+	return ranges
+}
+
+// Calls fn with the part in front of the comment of every line which isn't empty
+func forEachLine(path string, fn func(fields string)) {
+	file, err := os.Open(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		if strings.TrimSpace(line) != "" {
+			fn(line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func parseCodePoint(hex string) int {
+	code, err := strconv.ParseInt(hex, 16, 32)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return int(code)
+}
+
+func write(path string, source []byte) {
+	formatted, err := format.Source(source)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(path, formatted, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,174 @@
+//go:build ignore
+
+// Generates normalizationtables.go from the Unicode Character Database.
+// Download UnicodeData.txt and DerivedNormalizationProps.txt of the wanted version into a directory and run
+//
+//	go run gen_normalization.go -ucd <directory> -version 15.0.0
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type mapping struct {
+	compatibility bool
+	runes         []rune
+}
+
+func main() {
+	ucd := flag.String("ucd", "ucd", "directory with the files of the Unicode Character Database")
+	version := flag.String("version", "", "version of the Unicode Character Database")
+	flag.Parse()
+
+	ccc := map[rune]int{}
+	mappings := map[rune]mapping{}
+	forEachLine(filepath.Join(*ucd, "UnicodeData.txt"), func(line string) {
+		fields := strings.Split(line, ";")
+		if len(fields) < 6 {
+			log.Fatalf("UnicodeData.txt: invalid line %q", line)
+		}
+		r := parseCodePoint(fields[0])
+		if class, _ := strconv.Atoi(fields[3]); class != 0 {
+			ccc[r] = class
+		}
+		if fields[5] != "" {
+			m := mapping{}
+			for _, code := range strings.Fields(fields[5]) {
+				if strings.HasPrefix(code, "<") {
+					m.compatibility = true
+					continue
+				}
+				m.runes = append(m.runes, parseCodePoint(code))
+			}
+			mappings[r] = m
+		}
+	})
+
+	excluded := map[rune]bool{}
+	forEachLine(filepath.Join(*ucd, "DerivedNormalizationProps.txt"), func(line string) {
+		parts := strings.Split(line, ";")
+		if len(parts) < 2 || strings.TrimSpace(parts[1]) != "Full_Composition_Exclusion" {
+			return
+		}
+		codes := strings.Split(strings.TrimSpace(parts[0]), "..")
+		for r := parseCodePoint(codes[0]); r <= parseCodePoint(codes[len(codes)-1]); r++ {
+			excluded[r] = true
+		}
+	})
+
+	var decompose func(r rune, compatibility bool) []rune
+	decompose = func(r rune, compatibility bool) []rune {
+		m, ok := mappings[r]
+		if !ok || m.compatibility && !compatibility {
+			return []rune{r}
+		}
+		var result []rune
+		for _, part := range m.runes {
+			result = append(result, decompose(part, compatibility)...)
+		}
+		return result
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by gen_normalization.go from the Unicode Character Database %s. DO NOT EDIT.\n\n", *version)
+	fmt.Fprintf(&b, "package Text\n\n")
+	fmt.Fprintf(&b, "// Canonical combining classes of UnicodeData.txt, sorted by code point. Code points which are not listed have class 0.\n")
+	fmt.Fprintf(&b, "// See https://www.unicode.org/license.html for the Unicode license agreement.\n")
+	fmt.Fprintf(&b, "var combiningClassTable = []combiningClassRange{\n")
+	codes := sortedKeys(ccc)
+	for i := 0; i < len(codes); {
+		j := i
+		for j+1 < len(codes) && codes[j+1] == codes[j]+1 && ccc[codes[j+1]] == ccc[codes[i]] {
+			j++
+		}
+		fmt.Fprintf(&b, "\t{0x%04X, 0x%04X, %d},\n", codes[i], codes[j], ccc[codes[i]])
+		i = j + 1
+	}
+	fmt.Fprintf(&b, "}\n\n")
+
+	fmt.Fprintf(&b, "// Full canonical decompositions, Hangul syllables are decomposed algorithmically\n")
+	fmt.Fprintf(&b, "var canonicalDecompositions = map[rune]string{\n")
+	codes = sortedKeys(mappings)
+	for _, r := range codes {
+		if !mappings[r].compatibility {
+			fmt.Fprintf(&b, "\t0x%04X: %s,\n", r, strconv.QuoteToASCII(string(decompose(r, false))))
+		}
+	}
+	fmt.Fprintf(&b, "}\n\n")
+
+	fmt.Fprintf(&b, "// Full compatibility decompositions of the runes whose compatibility decomposition differs from the canonical one\n")
+	fmt.Fprintf(&b, "var compatibilityDecompositions = map[rune]string{\n")
+	for _, r := range codes {
+		if compatibility := string(decompose(r, true)); compatibility != string(decompose(r, false)) {
+			fmt.Fprintf(&b, "\t0x%04X: %s,\n", r, strconv.QuoteToASCII(compatibility))
+		}
+	}
+	fmt.Fprintf(&b, "}\n\n")
+
+	fmt.Fprintf(&b, "// Primary composites by the two runes of their canonical decomposition\n")
+	fmt.Fprintf(&b, "var canonicalCompositions = map[[2]rune]rune{\n")
+	for _, r := range codes {
+		m := mappings[r]
+		if !m.compatibility && len(m.runes) == 2 && !excluded[r] {
+			fmt.Fprintf(&b, "\t{0x%04X, 0x%04X}: 0x%04X,\n", m.runes[0], m.runes[1], r)
+		}
+	}
+	fmt.Fprintf(&b, "}\n")
+
+	formatted, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("normalizationtables.go", formatted, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func sortedKeys[V any](m map[rune]V) []rune {
+	keys := make([]rune, 0, len(m))
+	for r := range m {
+		keys = append(keys, r)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+
+	return keys
+}
+
+// Calls fn with the part in front of the comment of every line which isn't empty
+func forEachLine(path string, fn func(line string)) {
+	file, err := os.Open(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		if strings.TrimSpace(line) != "" {
+			fn(line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func parseCodePoint(hex string) rune {
+	code, err := strconv.ParseInt(hex, 16, 32)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return rune(code)
+}
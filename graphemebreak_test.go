@@ -0,0 +1,613 @@
+// Code generated by gen_graphemes.go from the Unicode Character Database 15.0.0. DO NOT EDIT.
+
+package Text
+
+// Test cases of auxiliary/GraphemeBreakTest.txt: a text and its grapheme clusters.
+// See https://www.unicode.org/license.html for the Unicode license agreement.
+var graphemeBreakTests = []struct {
+	text     string
+	clusters []string
+}{
+	{"\U00000020\U00000020", []string{"\U00000020", "\U00000020"}},
+	{"\U00000020\U00000308\U00000020", []string{"\U00000020\U00000308", "\U00000020"}},
+	{"\U00000020\U0000000D", []string{"\U00000020", "\U0000000D"}},
+	{"\U00000020\U00000308\U0000000D", []string{"\U00000020\U00000308", "\U0000000D"}},
+	{"\U00000020\U0000000A", []string{"\U00000020", "\U0000000A"}},
+	{"\U00000020\U00000308\U0000000A", []string{"\U00000020\U00000308", "\U0000000A"}},
+	{"\U00000020\U00000001", []string{"\U00000020", "\U00000001"}},
+	{"\U00000020\U00000308\U00000001", []string{"\U00000020\U00000308", "\U00000001"}},
+	{"\U00000020\U0000034F", []string{"\U00000020\U0000034F"}},
+	{"\U00000020\U00000308\U0000034F", []string{"\U00000020\U00000308\U0000034F"}},
+	{"\U00000020\U0001F1E6", []string{"\U00000020", "\U0001F1E6"}},
+	{"\U00000020\U00000308\U0001F1E6", []string{"\U00000020\U00000308", "\U0001F1E6"}},
+	{"\U00000020\U00000600", []string{"\U00000020", "\U00000600"}},
+	{"\U00000020\U00000308\U00000600", []string{"\U00000020\U00000308", "\U00000600"}},
+	{"\U00000020\U00000903", []string{"\U00000020\U00000903"}},
+	{"\U00000020\U00000308\U00000903", []string{"\U00000020\U00000308\U00000903"}},
+	{"\U00000020\U00001100", []string{"\U00000020", "\U00001100"}},
+	{"\U00000020\U00000308\U00001100", []string{"\U00000020\U00000308", "\U00001100"}},
+	{"\U00000020\U00001160", []string{"\U00000020", "\U00001160"}},
+	{"\U00000020\U00000308\U00001160", []string{"\U00000020\U00000308", "\U00001160"}},
+	{"\U00000020\U000011A8", []string{"\U00000020", "\U000011A8"}},
+	{"\U00000020\U00000308\U000011A8", []string{"\U00000020\U00000308", "\U000011A8"}},
+	{"\U00000020\U0000AC00", []string{"\U00000020", "\U0000AC00"}},
+	{"\U00000020\U00000308\U0000AC00", []string{"\U00000020\U00000308", "\U0000AC00"}},
+	{"\U00000020\U0000AC01", []string{"\U00000020", "\U0000AC01"}},
+	{"\U00000020\U00000308\U0000AC01", []string{"\U00000020\U00000308", "\U0000AC01"}},
+	{"\U00000020\U0000231A", []string{"\U00000020", "\U0000231A"}},
+	{"\U00000020\U00000308\U0000231A", []string{"\U00000020\U00000308", "\U0000231A"}},
+	{"\U00000020\U00000300", []string{"\U00000020\U00000300"}},
+	{"\U00000020\U00000308\U00000300", []string{"\U00000020\U00000308\U00000300"}},
+	{"\U00000020\U0000200D", []string{"\U00000020\U0000200D"}},
+	{"\U00000020\U00000308\U0000200D", []string{"\U00000020\U00000308\U0000200D"}},
+	{"\U00000020\U00000378", []string{"\U00000020", "\U00000378"}},
+	{"\U00000020\U00000308\U00000378", []string{"\U00000020\U00000308", "\U00000378"}},
+	{"\U0000000D\U00000020", []string{"\U0000000D", "\U00000020"}},
+	{"\U0000000D\U00000308\U00000020", []string{"\U0000000D", "\U00000308", "\U00000020"}},
+	{"\U0000000D\U0000000D", []string{"\U0000000D", "\U0000000D"}},
+	{"\U0000000D\U00000308\U0000000D", []string{"\U0000000D", "\U00000308", "\U0000000D"}},
+	{"\U0000000D\U0000000A", []string{"\U0000000D\U0000000A"}},
+	{"\U0000000D\U00000308\U0000000A", []string{"\U0000000D", "\U00000308", "\U0000000A"}},
+	{"\U0000000D\U00000001", []string{"\U0000000D", "\U00000001"}},
+	{"\U0000000D\U00000308\U00000001", []string{"\U0000000D", "\U00000308", "\U00000001"}},
+	{"\U0000000D\U0000034F", []string{"\U0000000D", "\U0000034F"}},
+	{"\U0000000D\U00000308\U0000034F", []string{"\U0000000D", "\U00000308\U0000034F"}},
+	{"\U0000000D\U0001F1E6", []string{"\U0000000D", "\U0001F1E6"}},
+	{"\U0000000D\U00000308\U0001F1E6", []string{"\U0000000D", "\U00000308", "\U0001F1E6"}},
+	{"\U0000000D\U00000600", []string{"\U0000000D", "\U00000600"}},
+	{"\U0000000D\U00000308\U00000600", []string{"\U0000000D", "\U00000308", "\U00000600"}},
+	{"\U0000000D\U00000903", []string{"\U0000000D", "\U00000903"}},
+	{"\U0000000D\U00000308\U00000903", []string{"\U0000000D", "\U00000308\U00000903"}},
+	{"\U0000000D\U00001100", []string{"\U0000000D", "\U00001100"}},
+	{"\U0000000D\U00000308\U00001100", []string{"\U0000000D", "\U00000308", "\U00001100"}},
+	{"\U0000000D\U00001160", []string{"\U0000000D", "\U00001160"}},
+	{"\U0000000D\U00000308\U00001160", []string{"\U0000000D", "\U00000308", "\U00001160"}},
+	{"\U0000000D\U000011A8", []string{"\U0000000D", "\U000011A8"}},
+	{"\U0000000D\U00000308\U000011A8", []string{"\U0000000D", "\U00000308", "\U000011A8"}},
+	{"\U0000000D\U0000AC00", []string{"\U0000000D", "\U0000AC00"}},
+	{"\U0000000D\U00000308\U0000AC00", []string{"\U0000000D", "\U00000308", "\U0000AC00"}},
+	{"\U0000000D\U0000AC01", []string{"\U0000000D", "\U0000AC01"}},
+	{"\U0000000D\U00000308\U0000AC01", []string{"\U0000000D", "\U00000308", "\U0000AC01"}},
+	{"\U0000000D\U0000231A", []string{"\U0000000D", "\U0000231A"}},
+	{"\U0000000D\U00000308\U0000231A", []string{"\U0000000D", "\U00000308", "\U0000231A"}},
+	{"\U0000000D\U00000300", []string{"\U0000000D", "\U00000300"}},
+	{"\U0000000D\U00000308\U00000300", []string{"\U0000000D", "\U00000308\U00000300"}},
+	{"\U0000000D\U0000200D", []string{"\U0000000D", "\U0000200D"}},
+	{"\U0000000D\U00000308\U0000200D", []string{"\U0000000D", "\U00000308\U0000200D"}},
+	{"\U0000000D\U00000378", []string{"\U0000000D", "\U00000378"}},
+	{"\U0000000D\U00000308\U00000378", []string{"\U0000000D", "\U00000308", "\U00000378"}},
+	{"\U0000000A\U00000020", []string{"\U0000000A", "\U00000020"}},
+	{"\U0000000A\U00000308\U00000020", []string{"\U0000000A", "\U00000308", "\U00000020"}},
+	{"\U0000000A\U0000000D", []string{"\U0000000A", "\U0000000D"}},
+	{"\U0000000A\U00000308\U0000000D", []string{"\U0000000A", "\U00000308", "\U0000000D"}},
+	{"\U0000000A\U0000000A", []string{"\U0000000A", "\U0000000A"}},
+	{"\U0000000A\U00000308\U0000000A", []string{"\U0000000A", "\U00000308", "\U0000000A"}},
+	{"\U0000000A\U00000001", []string{"\U0000000A", "\U00000001"}},
+	{"\U0000000A\U00000308\U00000001", []string{"\U0000000A", "\U00000308", "\U00000001"}},
+	{"\U0000000A\U0000034F", []string{"\U0000000A", "\U0000034F"}},
+	{"\U0000000A\U00000308\U0000034F", []string{"\U0000000A", "\U00000308\U0000034F"}},
+	{"\U0000000A\U0001F1E6", []string{"\U0000000A", "\U0001F1E6"}},
+	{"\U0000000A\U00000308\U0001F1E6", []string{"\U0000000A", "\U00000308", "\U0001F1E6"}},
+	{"\U0000000A\U00000600", []string{"\U0000000A", "\U00000600"}},
+	{"\U0000000A\U00000308\U00000600", []string{"\U0000000A", "\U00000308", "\U00000600"}},
+	{"\U0000000A\U00000903", []string{"\U0000000A", "\U00000903"}},
+	{"\U0000000A\U00000308\U00000903", []string{"\U0000000A", "\U00000308\U00000903"}},
+	{"\U0000000A\U00001100", []string{"\U0000000A", "\U00001100"}},
+	{"\U0000000A\U00000308\U00001100", []string{"\U0000000A", "\U00000308", "\U00001100"}},
+	{"\U0000000A\U00001160", []string{"\U0000000A", "\U00001160"}},
+	{"\U0000000A\U00000308\U00001160", []string{"\U0000000A", "\U00000308", "\U00001160"}},
+	{"\U0000000A\U000011A8", []string{"\U0000000A", "\U000011A8"}},
+	{"\U0000000A\U00000308\U000011A8", []string{"\U0000000A", "\U00000308", "\U000011A8"}},
+	{"\U0000000A\U0000AC00", []string{"\U0000000A", "\U0000AC00"}},
+	{"\U0000000A\U00000308\U0000AC00", []string{"\U0000000A", "\U00000308", "\U0000AC00"}},
+	{"\U0000000A\U0000AC01", []string{"\U0000000A", "\U0000AC01"}},
+	{"\U0000000A\U00000308\U0000AC01", []string{"\U0000000A", "\U00000308", "\U0000AC01"}},
+	{"\U0000000A\U0000231A", []string{"\U0000000A", "\U0000231A"}},
+	{"\U0000000A\U00000308\U0000231A", []string{"\U0000000A", "\U00000308", "\U0000231A"}},
+	{"\U0000000A\U00000300", []string{"\U0000000A", "\U00000300"}},
+	{"\U0000000A\U00000308\U00000300", []string{"\U0000000A", "\U00000308\U00000300"}},
+	{"\U0000000A\U0000200D", []string{"\U0000000A", "\U0000200D"}},
+	{"\U0000000A\U00000308\U0000200D", []string{"\U0000000A", "\U00000308\U0000200D"}},
+	{"\U0000000A\U00000378", []string{"\U0000000A", "\U00000378"}},
+	{"\U0000000A\U00000308\U00000378", []string{"\U0000000A", "\U00000308", "\U00000378"}},
+	{"\U00000001\U00000020", []string{"\U00000001", "\U00000020"}},
+	{"\U00000001\U00000308\U00000020", []string{"\U00000001", "\U00000308", "\U00000020"}},
+	{"\U00000001\U0000000D", []string{"\U00000001", "\U0000000D"}},
+	{"\U00000001\U00000308\U0000000D", []string{"\U00000001", "\U00000308", "\U0000000D"}},
+	{"\U00000001\U0000000A", []string{"\U00000001", "\U0000000A"}},
+	{"\U00000001\U00000308\U0000000A", []string{"\U00000001", "\U00000308", "\U0000000A"}},
+	{"\U00000001\U00000001", []string{"\U00000001", "\U00000001"}},
+	{"\U00000001\U00000308\U00000001", []string{"\U00000001", "\U00000308", "\U00000001"}},
+	{"\U00000001\U0000034F", []string{"\U00000001", "\U0000034F"}},
+	{"\U00000001\U00000308\U0000034F", []string{"\U00000001", "\U00000308\U0000034F"}},
+	{"\U00000001\U0001F1E6", []string{"\U00000001", "\U0001F1E6"}},
+	{"\U00000001\U00000308\U0001F1E6", []string{"\U00000001", "\U00000308", "\U0001F1E6"}},
+	{"\U00000001\U00000600", []string{"\U00000001", "\U00000600"}},
+	{"\U00000001\U00000308\U00000600", []string{"\U00000001", "\U00000308", "\U00000600"}},
+	{"\U00000001\U00000903", []string{"\U00000001", "\U00000903"}},
+	{"\U00000001\U00000308\U00000903", []string{"\U00000001", "\U00000308\U00000903"}},
+	{"\U00000001\U00001100", []string{"\U00000001", "\U00001100"}},
+	{"\U00000001\U00000308\U00001100", []string{"\U00000001", "\U00000308", "\U00001100"}},
+	{"\U00000001\U00001160", []string{"\U00000001", "\U00001160"}},
+	{"\U00000001\U00000308\U00001160", []string{"\U00000001", "\U00000308", "\U00001160"}},
+	{"\U00000001\U000011A8", []string{"\U00000001", "\U000011A8"}},
+	{"\U00000001\U00000308\U000011A8", []string{"\U00000001", "\U00000308", "\U000011A8"}},
+	{"\U00000001\U0000AC00", []string{"\U00000001", "\U0000AC00"}},
+	{"\U00000001\U00000308\U0000AC00", []string{"\U00000001", "\U00000308", "\U0000AC00"}},
+	{"\U00000001\U0000AC01", []string{"\U00000001", "\U0000AC01"}},
+	{"\U00000001\U00000308\U0000AC01", []string{"\U00000001", "\U00000308", "\U0000AC01"}},
+	{"\U00000001\U0000231A", []string{"\U00000001", "\U0000231A"}},
+	{"\U00000001\U00000308\U0000231A", []string{"\U00000001", "\U00000308", "\U0000231A"}},
+	{"\U00000001\U00000300", []string{"\U00000001", "\U00000300"}},
+	{"\U00000001\U00000308\U00000300", []string{"\U00000001", "\U00000308\U00000300"}},
+	{"\U00000001\U0000200D", []string{"\U00000001", "\U0000200D"}},
+	{"\U00000001\U00000308\U0000200D", []string{"\U00000001", "\U00000308\U0000200D"}},
+	{"\U00000001\U00000378", []string{"\U00000001", "\U00000378"}},
+	{"\U00000001\U00000308\U00000378", []string{"\U00000001", "\U00000308", "\U00000378"}},
+	{"\U0000034F\U00000020", []string{"\U0000034F", "\U00000020"}},
+	{"\U0000034F\U00000308\U00000020", []string{"\U0000034F\U00000308", "\U00000020"}},
+	{"\U0000034F\U0000000D", []string{"\U0000034F", "\U0000000D"}},
+	{"\U0000034F\U00000308\U0000000D", []string{"\U0000034F\U00000308", "\U0000000D"}},
+	{"\U0000034F\U0000000A", []string{"\U0000034F", "\U0000000A"}},
+	{"\U0000034F\U00000308\U0000000A", []string{"\U0000034F\U00000308", "\U0000000A"}},
+	{"\U0000034F\U00000001", []string{"\U0000034F", "\U00000001"}},
+	{"\U0000034F\U00000308\U00000001", []string{"\U0000034F\U00000308", "\U00000001"}},
+	{"\U0000034F\U0000034F", []string{"\U0000034F\U0000034F"}},
+	{"\U0000034F\U00000308\U0000034F", []string{"\U0000034F\U00000308\U0000034F"}},
+	{"\U0000034F\U0001F1E6", []string{"\U0000034F", "\U0001F1E6"}},
+	{"\U0000034F\U00000308\U0001F1E6", []string{"\U0000034F\U00000308", "\U0001F1E6"}},
+	{"\U0000034F\U00000600", []string{"\U0000034F", "\U00000600"}},
+	{"\U0000034F\U00000308\U00000600", []string{"\U0000034F\U00000308", "\U00000600"}},
+	{"\U0000034F\U00000903", []string{"\U0000034F\U00000903"}},
+	{"\U0000034F\U00000308\U00000903", []string{"\U0000034F\U00000308\U00000903"}},
+	{"\U0000034F\U00001100", []string{"\U0000034F", "\U00001100"}},
+	{"\U0000034F\U00000308\U00001100", []string{"\U0000034F\U00000308", "\U00001100"}},
+	{"\U0000034F\U00001160", []string{"\U0000034F", "\U00001160"}},
+	{"\U0000034F\U00000308\U00001160", []string{"\U0000034F\U00000308", "\U00001160"}},
+	{"\U0000034F\U000011A8", []string{"\U0000034F", "\U000011A8"}},
+	{"\U0000034F\U00000308\U000011A8", []string{"\U0000034F\U00000308", "\U000011A8"}},
+	{"\U0000034F\U0000AC00", []string{"\U0000034F", "\U0000AC00"}},
+	{"\U0000034F\U00000308\U0000AC00", []string{"\U0000034F\U00000308", "\U0000AC00"}},
+	{"\U0000034F\U0000AC01", []string{"\U0000034F", "\U0000AC01"}},
+	{"\U0000034F\U00000308\U0000AC01", []string{"\U0000034F\U00000308", "\U0000AC01"}},
+	{"\U0000034F\U0000231A", []string{"\U0000034F", "\U0000231A"}},
+	{"\U0000034F\U00000308\U0000231A", []string{"\U0000034F\U00000308", "\U0000231A"}},
+	{"\U0000034F\U00000300", []string{"\U0000034F\U00000300"}},
+	{"\U0000034F\U00000308\U00000300", []string{"\U0000034F\U00000308\U00000300"}},
+	{"\U0000034F\U0000200D", []string{"\U0000034F\U0000200D"}},
+	{"\U0000034F\U00000308\U0000200D", []string{"\U0000034F\U00000308\U0000200D"}},
+	{"\U0000034F\U00000378", []string{"\U0000034F", "\U00000378"}},
+	{"\U0000034F\U00000308\U00000378", []string{"\U0000034F\U00000308", "\U00000378"}},
+	{"\U0001F1E6\U00000020", []string{"\U0001F1E6", "\U00000020"}},
+	{"\U0001F1E6\U00000308\U00000020", []string{"\U0001F1E6\U00000308", "\U00000020"}},
+	{"\U0001F1E6\U0000000D", []string{"\U0001F1E6", "\U0000000D"}},
+	{"\U0001F1E6\U00000308\U0000000D", []string{"\U0001F1E6\U00000308", "\U0000000D"}},
+	{"\U0001F1E6\U0000000A", []string{"\U0001F1E6", "\U0000000A"}},
+	{"\U0001F1E6\U00000308\U0000000A", []string{"\U0001F1E6\U00000308", "\U0000000A"}},
+	{"\U0001F1E6\U00000001", []string{"\U0001F1E6", "\U00000001"}},
+	{"\U0001F1E6\U00000308\U00000001", []string{"\U0001F1E6\U00000308", "\U00000001"}},
+	{"\U0001F1E6\U0000034F", []string{"\U0001F1E6\U0000034F"}},
+	{"\U0001F1E6\U00000308\U0000034F", []string{"\U0001F1E6\U00000308\U0000034F"}},
+	{"\U0001F1E6\U0001F1E6", []string{"\U0001F1E6\U0001F1E6"}},
+	{"\U0001F1E6\U00000308\U0001F1E6", []string{"\U0001F1E6\U00000308", "\U0001F1E6"}},
+	{"\U0001F1E6\U00000600", []string{"\U0001F1E6", "\U00000600"}},
+	{"\U0001F1E6\U00000308\U00000600", []string{"\U0001F1E6\U00000308", "\U00000600"}},
+	{"\U0001F1E6\U00000903", []string{"\U0001F1E6\U00000903"}},
+	{"\U0001F1E6\U00000308\U00000903", []string{"\U0001F1E6\U00000308\U00000903"}},
+	{"\U0001F1E6\U00001100", []string{"\U0001F1E6", "\U00001100"}},
+	{"\U0001F1E6\U00000308\U00001100", []string{"\U0001F1E6\U00000308", "\U00001100"}},
+	{"\U0001F1E6\U00001160", []string{"\U0001F1E6", "\U00001160"}},
+	{"\U0001F1E6\U00000308\U00001160", []string{"\U0001F1E6\U00000308", "\U00001160"}},
+	{"\U0001F1E6\U000011A8", []string{"\U0001F1E6", "\U000011A8"}},
+	{"\U0001F1E6\U00000308\U000011A8", []string{"\U0001F1E6\U00000308", "\U000011A8"}},
+	{"\U0001F1E6\U0000AC00", []string{"\U0001F1E6", "\U0000AC00"}},
+	{"\U0001F1E6\U00000308\U0000AC00", []string{"\U0001F1E6\U00000308", "\U0000AC00"}},
+	{"\U0001F1E6\U0000AC01", []string{"\U0001F1E6", "\U0000AC01"}},
+	{"\U0001F1E6\U00000308\U0000AC01", []string{"\U0001F1E6\U00000308", "\U0000AC01"}},
+	{"\U0001F1E6\U0000231A", []string{"\U0001F1E6", "\U0000231A"}},
+	{"\U0001F1E6\U00000308\U0000231A", []string{"\U0001F1E6\U00000308", "\U0000231A"}},
+	{"\U0001F1E6\U00000300", []string{"\U0001F1E6\U00000300"}},
+	{"\U0001F1E6\U00000308\U00000300", []string{"\U0001F1E6\U00000308\U00000300"}},
+	{"\U0001F1E6\U0000200D", []string{"\U0001F1E6\U0000200D"}},
+	{"\U0001F1E6\U00000308\U0000200D", []string{"\U0001F1E6\U00000308\U0000200D"}},
+	{"\U0001F1E6\U00000378", []string{"\U0001F1E6", "\U00000378"}},
+	{"\U0001F1E6\U00000308\U00000378", []string{"\U0001F1E6\U00000308", "\U00000378"}},
+	{"\U00000600\U00000020", []string{"\U00000600\U00000020"}},
+	{"\U00000600\U00000308\U00000020", []string{"\U00000600\U00000308", "\U00000020"}},
+	{"\U00000600\U0000000D", []string{"\U00000600", "\U0000000D"}},
+	{"\U00000600\U00000308\U0000000D", []string{"\U00000600\U00000308", "\U0000000D"}},
+	{"\U00000600\U0000000A", []string{"\U00000600", "\U0000000A"}},
+	{"\U00000600\U00000308\U0000000A", []string{"\U00000600\U00000308", "\U0000000A"}},
+	{"\U00000600\U00000001", []string{"\U00000600", "\U00000001"}},
+	{"\U00000600\U00000308\U00000001", []string{"\U00000600\U00000308", "\U00000001"}},
+	{"\U00000600\U0000034F", []string{"\U00000600\U0000034F"}},
+	{"\U00000600\U00000308\U0000034F", []string{"\U00000600\U00000308\U0000034F"}},
+	{"\U00000600\U0001F1E6", []string{"\U00000600\U0001F1E6"}},
+	{"\U00000600\U00000308\U0001F1E6", []string{"\U00000600\U00000308", "\U0001F1E6"}},
+	{"\U00000600\U00000600", []string{"\U00000600\U00000600"}},
+	{"\U00000600\U00000308\U00000600", []string{"\U00000600\U00000308", "\U00000600"}},
+	{"\U00000600\U00000903", []string{"\U00000600\U00000903"}},
+	{"\U00000600\U00000308\U00000903", []string{"\U00000600\U00000308\U00000903"}},
+	{"\U00000600\U00001100", []string{"\U00000600\U00001100"}},
+	{"\U00000600\U00000308\U00001100", []string{"\U00000600\U00000308", "\U00001100"}},
+	{"\U00000600\U00001160", []string{"\U00000600\U00001160"}},
+	{"\U00000600\U00000308\U00001160", []string{"\U00000600\U00000308", "\U00001160"}},
+	{"\U00000600\U000011A8", []string{"\U00000600\U000011A8"}},
+	{"\U00000600\U00000308\U000011A8", []string{"\U00000600\U00000308", "\U000011A8"}},
+	{"\U00000600\U0000AC00", []string{"\U00000600\U0000AC00"}},
+	{"\U00000600\U00000308\U0000AC00", []string{"\U00000600\U00000308", "\U0000AC00"}},
+	{"\U00000600\U0000AC01", []string{"\U00000600\U0000AC01"}},
+	{"\U00000600\U00000308\U0000AC01", []string{"\U00000600\U00000308", "\U0000AC01"}},
+	{"\U00000600\U0000231A", []string{"\U00000600\U0000231A"}},
+	{"\U00000600\U00000308\U0000231A", []string{"\U00000600\U00000308", "\U0000231A"}},
+	{"\U00000600\U00000300", []string{"\U00000600\U00000300"}},
+	{"\U00000600\U00000308\U00000300", []string{"\U00000600\U00000308\U00000300"}},
+	{"\U00000600\U0000200D", []string{"\U00000600\U0000200D"}},
+	{"\U00000600\U00000308\U0000200D", []string{"\U00000600\U00000308\U0000200D"}},
+	{"\U00000600\U00000378", []string{"\U00000600\U00000378"}},
+	{"\U00000600\U00000308\U00000378", []string{"\U00000600\U00000308", "\U00000378"}},
+	{"\U00000903\U00000020", []string{"\U00000903", "\U00000020"}},
+	{"\U00000903\U00000308\U00000020", []string{"\U00000903\U00000308", "\U00000020"}},
+	{"\U00000903\U0000000D", []string{"\U00000903", "\U0000000D"}},
+	{"\U00000903\U00000308\U0000000D", []string{"\U00000903\U00000308", "\U0000000D"}},
+	{"\U00000903\U0000000A", []string{"\U00000903", "\U0000000A"}},
+	{"\U00000903\U00000308\U0000000A", []string{"\U00000903\U00000308", "\U0000000A"}},
+	{"\U00000903\U00000001", []string{"\U00000903", "\U00000001"}},
+	{"\U00000903\U00000308\U00000001", []string{"\U00000903\U00000308", "\U00000001"}},
+	{"\U00000903\U0000034F", []string{"\U00000903\U0000034F"}},
+	{"\U00000903\U00000308\U0000034F", []string{"\U00000903\U00000308\U0000034F"}},
+	{"\U00000903\U0001F1E6", []string{"\U00000903", "\U0001F1E6"}},
+	{"\U00000903\U00000308\U0001F1E6", []string{"\U00000903\U00000308", "\U0001F1E6"}},
+	{"\U00000903\U00000600", []string{"\U00000903", "\U00000600"}},
+	{"\U00000903\U00000308\U00000600", []string{"\U00000903\U00000308", "\U00000600"}},
+	{"\U00000903\U00000903", []string{"\U00000903\U00000903"}},
+	{"\U00000903\U00000308\U00000903", []string{"\U00000903\U00000308\U00000903"}},
+	{"\U00000903\U00001100", []string{"\U00000903", "\U00001100"}},
+	{"\U00000903\U00000308\U00001100", []string{"\U00000903\U00000308", "\U00001100"}},
+	{"\U00000903\U00001160", []string{"\U00000903", "\U00001160"}},
+	{"\U00000903\U00000308\U00001160", []string{"\U00000903\U00000308", "\U00001160"}},
+	{"\U00000903\U000011A8", []string{"\U00000903", "\U000011A8"}},
+	{"\U00000903\U00000308\U000011A8", []string{"\U00000903\U00000308", "\U000011A8"}},
+	{"\U00000903\U0000AC00", []string{"\U00000903", "\U0000AC00"}},
+	{"\U00000903\U00000308\U0000AC00", []string{"\U00000903\U00000308", "\U0000AC00"}},
+	{"\U00000903\U0000AC01", []string{"\U00000903", "\U0000AC01"}},
+	{"\U00000903\U00000308\U0000AC01", []string{"\U00000903\U00000308", "\U0000AC01"}},
+	{"\U00000903\U0000231A", []string{"\U00000903", "\U0000231A"}},
+	{"\U00000903\U00000308\U0000231A", []string{"\U00000903\U00000308", "\U0000231A"}},
+	{"\U00000903\U00000300", []string{"\U00000903\U00000300"}},
+	{"\U00000903\U00000308\U00000300", []string{"\U00000903\U00000308\U00000300"}},
+	{"\U00000903\U0000200D", []string{"\U00000903\U0000200D"}},
+	{"\U00000903\U00000308\U0000200D", []string{"\U00000903\U00000308\U0000200D"}},
+	{"\U00000903\U00000378", []string{"\U00000903", "\U00000378"}},
+	{"\U00000903\U00000308\U00000378", []string{"\U00000903\U00000308", "\U00000378"}},
+	{"\U00001100\U00000020", []string{"\U00001100", "\U00000020"}},
+	{"\U00001100\U00000308\U00000020", []string{"\U00001100\U00000308", "\U00000020"}},
+	{"\U00001100\U0000000D", []string{"\U00001100", "\U0000000D"}},
+	{"\U00001100\U00000308\U0000000D", []string{"\U00001100\U00000308", "\U0000000D"}},
+	{"\U00001100\U0000000A", []string{"\U00001100", "\U0000000A"}},
+	{"\U00001100\U00000308\U0000000A", []string{"\U00001100\U00000308", "\U0000000A"}},
+	{"\U00001100\U00000001", []string{"\U00001100", "\U00000001"}},
+	{"\U00001100\U00000308\U00000001", []string{"\U00001100\U00000308", "\U00000001"}},
+	{"\U00001100\U0000034F", []string{"\U00001100\U0000034F"}},
+	{"\U00001100\U00000308\U0000034F", []string{"\U00001100\U00000308\U0000034F"}},
+	{"\U00001100\U0001F1E6", []string{"\U00001100", "\U0001F1E6"}},
+	{"\U00001100\U00000308\U0001F1E6", []string{"\U00001100\U00000308", "\U0001F1E6"}},
+	{"\U00001100\U00000600", []string{"\U00001100", "\U00000600"}},
+	{"\U00001100\U00000308\U00000600", []string{"\U00001100\U00000308", "\U00000600"}},
+	{"\U00001100\U00000903", []string{"\U00001100\U00000903"}},
+	{"\U00001100\U00000308\U00000903", []string{"\U00001100\U00000308\U00000903"}},
+	{"\U00001100\U00001100", []string{"\U00001100\U00001100"}},
+	{"\U00001100\U00000308\U00001100", []string{"\U00001100\U00000308", "\U00001100"}},
+	{"\U00001100\U00001160", []string{"\U00001100\U00001160"}},
+	{"\U00001100\U00000308\U00001160", []string{"\U00001100\U00000308", "\U00001160"}},
+	{"\U00001100\U000011A8", []string{"\U00001100", "\U000011A8"}},
+	{"\U00001100\U00000308\U000011A8", []string{"\U00001100\U00000308", "\U000011A8"}},
+	{"\U00001100\U0000AC00", []string{"\U00001100\U0000AC00"}},
+	{"\U00001100\U00000308\U0000AC00", []string{"\U00001100\U00000308", "\U0000AC00"}},
+	{"\U00001100\U0000AC01", []string{"\U00001100\U0000AC01"}},
+	{"\U00001100\U00000308\U0000AC01", []string{"\U00001100\U00000308", "\U0000AC01"}},
+	{"\U00001100\U0000231A", []string{"\U00001100", "\U0000231A"}},
+	{"\U00001100\U00000308\U0000231A", []string{"\U00001100\U00000308", "\U0000231A"}},
+	{"\U00001100\U00000300", []string{"\U00001100\U00000300"}},
+	{"\U00001100\U00000308\U00000300", []string{"\U00001100\U00000308\U00000300"}},
+	{"\U00001100\U0000200D", []string{"\U00001100\U0000200D"}},
+	{"\U00001100\U00000308\U0000200D", []string{"\U00001100\U00000308\U0000200D"}},
+	{"\U00001100\U00000378", []string{"\U00001100", "\U00000378"}},
+	{"\U00001100\U00000308\U00000378", []string{"\U00001100\U00000308", "\U00000378"}},
+	{"\U00001160\U00000020", []string{"\U00001160", "\U00000020"}},
+	{"\U00001160\U00000308\U00000020", []string{"\U00001160\U00000308", "\U00000020"}},
+	{"\U00001160\U0000000D", []string{"\U00001160", "\U0000000D"}},
+	{"\U00001160\U00000308\U0000000D", []string{"\U00001160\U00000308", "\U0000000D"}},
+	{"\U00001160\U0000000A", []string{"\U00001160", "\U0000000A"}},
+	{"\U00001160\U00000308\U0000000A", []string{"\U00001160\U00000308", "\U0000000A"}},
+	{"\U00001160\U00000001", []string{"\U00001160", "\U00000001"}},
+	{"\U00001160\U00000308\U00000001", []string{"\U00001160\U00000308", "\U00000001"}},
+	{"\U00001160\U0000034F", []string{"\U00001160\U0000034F"}},
+	{"\U00001160\U00000308\U0000034F", []string{"\U00001160\U00000308\U0000034F"}},
+	{"\U00001160\U0001F1E6", []string{"\U00001160", "\U0001F1E6"}},
+	{"\U00001160\U00000308\U0001F1E6", []string{"\U00001160\U00000308", "\U0001F1E6"}},
+	{"\U00001160\U00000600", []string{"\U00001160", "\U00000600"}},
+	{"\U00001160\U00000308\U00000600", []string{"\U00001160\U00000308", "\U00000600"}},
+	{"\U00001160\U00000903", []string{"\U00001160\U00000903"}},
+	{"\U00001160\U00000308\U00000903", []string{"\U00001160\U00000308\U00000903"}},
+	{"\U00001160\U00001100", []string{"\U00001160", "\U00001100"}},
+	{"\U00001160\U00000308\U00001100", []string{"\U00001160\U00000308", "\U00001100"}},
+	{"\U00001160\U00001160", []string{"\U00001160\U00001160"}},
+	{"\U00001160\U00000308\U00001160", []string{"\U00001160\U00000308", "\U00001160"}},
+	{"\U00001160\U000011A8", []string{"\U00001160\U000011A8"}},
+	{"\U00001160\U00000308\U000011A8", []string{"\U00001160\U00000308", "\U000011A8"}},
+	{"\U00001160\U0000AC00", []string{"\U00001160", "\U0000AC00"}},
+	{"\U00001160\U00000308\U0000AC00", []string{"\U00001160\U00000308", "\U0000AC00"}},
+	{"\U00001160\U0000AC01", []string{"\U00001160", "\U0000AC01"}},
+	{"\U00001160\U00000308\U0000AC01", []string{"\U00001160\U00000308", "\U0000AC01"}},
+	{"\U00001160\U0000231A", []string{"\U00001160", "\U0000231A"}},
+	{"\U00001160\U00000308\U0000231A", []string{"\U00001160\U00000308", "\U0000231A"}},
+	{"\U00001160\U00000300", []string{"\U00001160\U00000300"}},
+	{"\U00001160\U00000308\U00000300", []string{"\U00001160\U00000308\U00000300"}},
+	{"\U00001160\U0000200D", []string{"\U00001160\U0000200D"}},
+	{"\U00001160\U00000308\U0000200D", []string{"\U00001160\U00000308\U0000200D"}},
+	{"\U00001160\U00000378", []string{"\U00001160", "\U00000378"}},
+	{"\U00001160\U00000308\U00000378", []string{"\U00001160\U00000308", "\U00000378"}},
+	{"\U000011A8\U00000020", []string{"\U000011A8", "\U00000020"}},
+	{"\U000011A8\U00000308\U00000020", []string{"\U000011A8\U00000308", "\U00000020"}},
+	{"\U000011A8\U0000000D", []string{"\U000011A8", "\U0000000D"}},
+	{"\U000011A8\U00000308\U0000000D", []string{"\U000011A8\U00000308", "\U0000000D"}},
+	{"\U000011A8\U0000000A", []string{"\U000011A8", "\U0000000A"}},
+	{"\U000011A8\U00000308\U0000000A", []string{"\U000011A8\U00000308", "\U0000000A"}},
+	{"\U000011A8\U00000001", []string{"\U000011A8", "\U00000001"}},
+	{"\U000011A8\U00000308\U00000001", []string{"\U000011A8\U00000308", "\U00000001"}},
+	{"\U000011A8\U0000034F", []string{"\U000011A8\U0000034F"}},
+	{"\U000011A8\U00000308\U0000034F", []string{"\U000011A8\U00000308\U0000034F"}},
+	{"\U000011A8\U0001F1E6", []string{"\U000011A8", "\U0001F1E6"}},
+	{"\U000011A8\U00000308\U0001F1E6", []string{"\U000011A8\U00000308", "\U0001F1E6"}},
+	{"\U000011A8\U00000600", []string{"\U000011A8", "\U00000600"}},
+	{"\U000011A8\U00000308\U00000600", []string{"\U000011A8\U00000308", "\U00000600"}},
+	{"\U000011A8\U00000903", []string{"\U000011A8\U00000903"}},
+	{"\U000011A8\U00000308\U00000903", []string{"\U000011A8\U00000308\U00000903"}},
+	{"\U000011A8\U00001100", []string{"\U000011A8", "\U00001100"}},
+	{"\U000011A8\U00000308\U00001100", []string{"\U000011A8\U00000308", "\U00001100"}},
+	{"\U000011A8\U00001160", []string{"\U000011A8", "\U00001160"}},
+	{"\U000011A8\U00000308\U00001160", []string{"\U000011A8\U00000308", "\U00001160"}},
+	{"\U000011A8\U000011A8", []string{"\U000011A8\U000011A8"}},
+	{"\U000011A8\U00000308\U000011A8", []string{"\U000011A8\U00000308", "\U000011A8"}},
+	{"\U000011A8\U0000AC00", []string{"\U000011A8", "\U0000AC00"}},
+	{"\U000011A8\U00000308\U0000AC00", []string{"\U000011A8\U00000308", "\U0000AC00"}},
+	{"\U000011A8\U0000AC01", []string{"\U000011A8", "\U0000AC01"}},
+	{"\U000011A8\U00000308\U0000AC01", []string{"\U000011A8\U00000308", "\U0000AC01"}},
+	{"\U000011A8\U0000231A", []string{"\U000011A8", "\U0000231A"}},
+	{"\U000011A8\U00000308\U0000231A", []string{"\U000011A8\U00000308", "\U0000231A"}},
+	{"\U000011A8\U00000300", []string{"\U000011A8\U00000300"}},
+	{"\U000011A8\U00000308\U00000300", []string{"\U000011A8\U00000308\U00000300"}},
+	{"\U000011A8\U0000200D", []string{"\U000011A8\U0000200D"}},
+	{"\U000011A8\U00000308\U0000200D", []string{"\U000011A8\U00000308\U0000200D"}},
+	{"\U000011A8\U00000378", []string{"\U000011A8", "\U00000378"}},
+	{"\U000011A8\U00000308\U00000378", []string{"\U000011A8\U00000308", "\U00000378"}},
+	{"\U0000AC00\U00000020", []string{"\U0000AC00", "\U00000020"}},
+	{"\U0000AC00\U00000308\U00000020", []string{"\U0000AC00\U00000308", "\U00000020"}},
+	{"\U0000AC00\U0000000D", []string{"\U0000AC00", "\U0000000D"}},
+	{"\U0000AC00\U00000308\U0000000D", []string{"\U0000AC00\U00000308", "\U0000000D"}},
+	{"\U0000AC00\U0000000A", []string{"\U0000AC00", "\U0000000A"}},
+	{"\U0000AC00\U00000308\U0000000A", []string{"\U0000AC00\U00000308", "\U0000000A"}},
+	{"\U0000AC00\U00000001", []string{"\U0000AC00", "\U00000001"}},
+	{"\U0000AC00\U00000308\U00000001", []string{"\U0000AC00\U00000308", "\U00000001"}},
+	{"\U0000AC00\U0000034F", []string{"\U0000AC00\U0000034F"}},
+	{"\U0000AC00\U00000308\U0000034F", []string{"\U0000AC00\U00000308\U0000034F"}},
+	{"\U0000AC00\U0001F1E6", []string{"\U0000AC00", "\U0001F1E6"}},
+	{"\U0000AC00\U00000308\U0001F1E6", []string{"\U0000AC00\U00000308", "\U0001F1E6"}},
+	{"\U0000AC00\U00000600", []string{"\U0000AC00", "\U00000600"}},
+	{"\U0000AC00\U00000308\U00000600", []string{"\U0000AC00\U00000308", "\U00000600"}},
+	{"\U0000AC00\U00000903", []string{"\U0000AC00\U00000903"}},
+	{"\U0000AC00\U00000308\U00000903", []string{"\U0000AC00\U00000308\U00000903"}},
+	{"\U0000AC00\U00001100", []string{"\U0000AC00", "\U00001100"}},
+	{"\U0000AC00\U00000308\U00001100", []string{"\U0000AC00\U00000308", "\U00001100"}},
+	{"\U0000AC00\U00001160", []string{"\U0000AC00\U00001160"}},
+	{"\U0000AC00\U00000308\U00001160", []string{"\U0000AC00\U00000308", "\U00001160"}},
+	{"\U0000AC00\U000011A8", []string{"\U0000AC00\U000011A8"}},
+	{"\U0000AC00\U00000308\U000011A8", []string{"\U0000AC00\U00000308", "\U000011A8"}},
+	{"\U0000AC00\U0000AC00", []string{"\U0000AC00", "\U0000AC00"}},
+	{"\U0000AC00\U00000308\U0000AC00", []string{"\U0000AC00\U00000308", "\U0000AC00"}},
+	{"\U0000AC00\U0000AC01", []string{"\U0000AC00", "\U0000AC01"}},
+	{"\U0000AC00\U00000308\U0000AC01", []string{"\U0000AC00\U00000308", "\U0000AC01"}},
+	{"\U0000AC00\U0000231A", []string{"\U0000AC00", "\U0000231A"}},
+	{"\U0000AC00\U00000308\U0000231A", []string{"\U0000AC00\U00000308", "\U0000231A"}},
+	{"\U0000AC00\U00000300", []string{"\U0000AC00\U00000300"}},
+	{"\U0000AC00\U00000308\U00000300", []string{"\U0000AC00\U00000308\U00000300"}},
+	{"\U0000AC00\U0000200D", []string{"\U0000AC00\U0000200D"}},
+	{"\U0000AC00\U00000308\U0000200D", []string{"\U0000AC00\U00000308\U0000200D"}},
+	{"\U0000AC00\U00000378", []string{"\U0000AC00", "\U00000378"}},
+	{"\U0000AC00\U00000308\U00000378", []string{"\U0000AC00\U00000308", "\U00000378"}},
+	{"\U0000AC01\U00000020", []string{"\U0000AC01", "\U00000020"}},
+	{"\U0000AC01\U00000308\U00000020", []string{"\U0000AC01\U00000308", "\U00000020"}},
+	{"\U0000AC01\U0000000D", []string{"\U0000AC01", "\U0000000D"}},
+	{"\U0000AC01\U00000308\U0000000D", []string{"\U0000AC01\U00000308", "\U0000000D"}},
+	{"\U0000AC01\U0000000A", []string{"\U0000AC01", "\U0000000A"}},
+	{"\U0000AC01\U00000308\U0000000A", []string{"\U0000AC01\U00000308", "\U0000000A"}},
+	{"\U0000AC01\U00000001", []string{"\U0000AC01", "\U00000001"}},
+	{"\U0000AC01\U00000308\U00000001", []string{"\U0000AC01\U00000308", "\U00000001"}},
+	{"\U0000AC01\U0000034F", []string{"\U0000AC01\U0000034F"}},
+	{"\U0000AC01\U00000308\U0000034F", []string{"\U0000AC01\U00000308\U0000034F"}},
+	{"\U0000AC01\U0001F1E6", []string{"\U0000AC01", "\U0001F1E6"}},
+	{"\U0000AC01\U00000308\U0001F1E6", []string{"\U0000AC01\U00000308", "\U0001F1E6"}},
+	{"\U0000AC01\U00000600", []string{"\U0000AC01", "\U00000600"}},
+	{"\U0000AC01\U00000308\U00000600", []string{"\U0000AC01\U00000308", "\U00000600"}},
+	{"\U0000AC01\U00000903", []string{"\U0000AC01\U00000903"}},
+	{"\U0000AC01\U00000308\U00000903", []string{"\U0000AC01\U00000308\U00000903"}},
+	{"\U0000AC01\U00001100", []string{"\U0000AC01", "\U00001100"}},
+	{"\U0000AC01\U00000308\U00001100", []string{"\U0000AC01\U00000308", "\U00001100"}},
+	{"\U0000AC01\U00001160", []string{"\U0000AC01", "\U00001160"}},
+	{"\U0000AC01\U00000308\U00001160", []string{"\U0000AC01\U00000308", "\U00001160"}},
+	{"\U0000AC01\U000011A8", []string{"\U0000AC01\U000011A8"}},
+	{"\U0000AC01\U00000308\U000011A8", []string{"\U0000AC01\U00000308", "\U000011A8"}},
+	{"\U0000AC01\U0000AC00", []string{"\U0000AC01", "\U0000AC00"}},
+	{"\U0000AC01\U00000308\U0000AC00", []string{"\U0000AC01\U00000308", "\U0000AC00"}},
+	{"\U0000AC01\U0000AC01", []string{"\U0000AC01", "\U0000AC01"}},
+	{"\U0000AC01\U00000308\U0000AC01", []string{"\U0000AC01\U00000308", "\U0000AC01"}},
+	{"\U0000AC01\U0000231A", []string{"\U0000AC01", "\U0000231A"}},
+	{"\U0000AC01\U00000308\U0000231A", []string{"\U0000AC01\U00000308", "\U0000231A"}},
+	{"\U0000AC01\U00000300", []string{"\U0000AC01\U00000300"}},
+	{"\U0000AC01\U00000308\U00000300", []string{"\U0000AC01\U00000308\U00000300"}},
+	{"\U0000AC01\U0000200D", []string{"\U0000AC01\U0000200D"}},
+	{"\U0000AC01\U00000308\U0000200D", []string{"\U0000AC01\U00000308\U0000200D"}},
+	{"\U0000AC01\U00000378", []string{"\U0000AC01", "\U00000378"}},
+	{"\U0000AC01\U00000308\U00000378", []string{"\U0000AC01\U00000308", "\U00000378"}},
+	{"\U0000231A\U00000020", []string{"\U0000231A", "\U00000020"}},
+	{"\U0000231A\U00000308\U00000020", []string{"\U0000231A\U00000308", "\U00000020"}},
+	{"\U0000231A\U0000000D", []string{"\U0000231A", "\U0000000D"}},
+	{"\U0000231A\U00000308\U0000000D", []string{"\U0000231A\U00000308", "\U0000000D"}},
+	{"\U0000231A\U0000000A", []string{"\U0000231A", "\U0000000A"}},
+	{"\U0000231A\U00000308\U0000000A", []string{"\U0000231A\U00000308", "\U0000000A"}},
+	{"\U0000231A\U00000001", []string{"\U0000231A", "\U00000001"}},
+	{"\U0000231A\U00000308\U00000001", []string{"\U0000231A\U00000308", "\U00000001"}},
+	{"\U0000231A\U0000034F", []string{"\U0000231A\U0000034F"}},
+	{"\U0000231A\U00000308\U0000034F", []string{"\U0000231A\U00000308\U0000034F"}},
+	{"\U0000231A\U0001F1E6", []string{"\U0000231A", "\U0001F1E6"}},
+	{"\U0000231A\U00000308\U0001F1E6", []string{"\U0000231A\U00000308", "\U0001F1E6"}},
+	{"\U0000231A\U00000600", []string{"\U0000231A", "\U00000600"}},
+	{"\U0000231A\U00000308\U00000600", []string{"\U0000231A\U00000308", "\U00000600"}},
+	{"\U0000231A\U00000903", []string{"\U0000231A\U00000903"}},
+	{"\U0000231A\U00000308\U00000903", []string{"\U0000231A\U00000308\U00000903"}},
+	{"\U0000231A\U00001100", []string{"\U0000231A", "\U00001100"}},
+	{"\U0000231A\U00000308\U00001100", []string{"\U0000231A\U00000308", "\U00001100"}},
+	{"\U0000231A\U00001160", []string{"\U0000231A", "\U00001160"}},
+	{"\U0000231A\U00000308\U00001160", []string{"\U0000231A\U00000308", "\U00001160"}},
+	{"\U0000231A\U000011A8", []string{"\U0000231A", "\U000011A8"}},
+	{"\U0000231A\U00000308\U000011A8", []string{"\U0000231A\U00000308", "\U000011A8"}},
+	{"\U0000231A\U0000AC00", []string{"\U0000231A", "\U0000AC00"}},
+	{"\U0000231A\U00000308\U0000AC00", []string{"\U0000231A\U00000308", "\U0000AC00"}},
+	{"\U0000231A\U0000AC01", []string{"\U0000231A", "\U0000AC01"}},
+	{"\U0000231A\U00000308\U0000AC01", []string{"\U0000231A\U00000308", "\U0000AC01"}},
+	{"\U0000231A\U0000231A", []string{"\U0000231A", "\U0000231A"}},
+	{"\U0000231A\U00000308\U0000231A", []string{"\U0000231A\U00000308", "\U0000231A"}},
+	{"\U0000231A\U00000300", []string{"\U0000231A\U00000300"}},
+	{"\U0000231A\U00000308\U00000300", []string{"\U0000231A\U00000308\U00000300"}},
+	{"\U0000231A\U0000200D", []string{"\U0000231A\U0000200D"}},
+	{"\U0000231A\U00000308\U0000200D", []string{"\U0000231A\U00000308\U0000200D"}},
+	{"\U0000231A\U00000378", []string{"\U0000231A", "\U00000378"}},
+	{"\U0000231A\U00000308\U00000378", []string{"\U0000231A\U00000308", "\U00000378"}},
+	{"\U00000300\U00000020", []string{"\U00000300", "\U00000020"}},
+	{"\U00000300\U00000308\U00000020", []string{"\U00000300\U00000308", "\U00000020"}},
+	{"\U00000300\U0000000D", []string{"\U00000300", "\U0000000D"}},
+	{"\U00000300\U00000308\U0000000D", []string{"\U00000300\U00000308", "\U0000000D"}},
+	{"\U00000300\U0000000A", []string{"\U00000300", "\U0000000A"}},
+	{"\U00000300\U00000308\U0000000A", []string{"\U00000300\U00000308", "\U0000000A"}},
+	{"\U00000300\U00000001", []string{"\U00000300", "\U00000001"}},
+	{"\U00000300\U00000308\U00000001", []string{"\U00000300\U00000308", "\U00000001"}},
+	{"\U00000300\U0000034F", []string{"\U00000300\U0000034F"}},
+	{"\U00000300\U00000308\U0000034F", []string{"\U00000300\U00000308\U0000034F"}},
+	{"\U00000300\U0001F1E6", []string{"\U00000300", "\U0001F1E6"}},
+	{"\U00000300\U00000308\U0001F1E6", []string{"\U00000300\U00000308", "\U0001F1E6"}},
+	{"\U00000300\U00000600", []string{"\U00000300", "\U00000600"}},
+	{"\U00000300\U00000308\U00000600", []string{"\U00000300\U00000308", "\U00000600"}},
+	{"\U00000300\U00000903", []string{"\U00000300\U00000903"}},
+	{"\U00000300\U00000308\U00000903", []string{"\U00000300\U00000308\U00000903"}},
+	{"\U00000300\U00001100", []string{"\U00000300", "\U00001100"}},
+	{"\U00000300\U00000308\U00001100", []string{"\U00000300\U00000308", "\U00001100"}},
+	{"\U00000300\U00001160", []string{"\U00000300", "\U00001160"}},
+	{"\U00000300\U00000308\U00001160", []string{"\U00000300\U00000308", "\U00001160"}},
+	{"\U00000300\U000011A8", []string{"\U00000300", "\U000011A8"}},
+	{"\U00000300\U00000308\U000011A8", []string{"\U00000300\U00000308", "\U000011A8"}},
+	{"\U00000300\U0000AC00", []string{"\U00000300", "\U0000AC00"}},
+	{"\U00000300\U00000308\U0000AC00", []string{"\U00000300\U00000308", "\U0000AC00"}},
+	{"\U00000300\U0000AC01", []string{"\U00000300", "\U0000AC01"}},
+	{"\U00000300\U00000308\U0000AC01", []string{"\U00000300\U00000308", "\U0000AC01"}},
+	{"\U00000300\U0000231A", []string{"\U00000300", "\U0000231A"}},
+	{"\U00000300\U00000308\U0000231A", []string{"\U00000300\U00000308", "\U0000231A"}},
+	{"\U00000300\U00000300", []string{"\U00000300\U00000300"}},
+	{"\U00000300\U00000308\U00000300", []string{"\U00000300\U00000308\U00000300"}},
+	{"\U00000300\U0000200D", []string{"\U00000300\U0000200D"}},
+	{"\U00000300\U00000308\U0000200D", []string{"\U00000300\U00000308\U0000200D"}},
+	{"\U00000300\U00000378", []string{"\U00000300", "\U00000378"}},
+	{"\U00000300\U00000308\U00000378", []string{"\U00000300\U00000308", "\U00000378"}},
+	{"\U0000200D\U00000020", []string{"\U0000200D", "\U00000020"}},
+	{"\U0000200D\U00000308\U00000020", []string{"\U0000200D\U00000308", "\U00000020"}},
+	{"\U0000200D\U0000000D", []string{"\U0000200D", "\U0000000D"}},
+	{"\U0000200D\U00000308\U0000000D", []string{"\U0000200D\U00000308", "\U0000000D"}},
+	{"\U0000200D\U0000000A", []string{"\U0000200D", "\U0000000A"}},
+	{"\U0000200D\U00000308\U0000000A", []string{"\U0000200D\U00000308", "\U0000000A"}},
+	{"\U0000200D\U00000001", []string{"\U0000200D", "\U00000001"}},
+	{"\U0000200D\U00000308\U00000001", []string{"\U0000200D\U00000308", "\U00000001"}},
+	{"\U0000200D\U0000034F", []string{"\U0000200D\U0000034F"}},
+	{"\U0000200D\U00000308\U0000034F", []string{"\U0000200D\U00000308\U0000034F"}},
+	{"\U0000200D\U0001F1E6", []string{"\U0000200D", "\U0001F1E6"}},
+	{"\U0000200D\U00000308\U0001F1E6", []string{"\U0000200D\U00000308", "\U0001F1E6"}},
+	{"\U0000200D\U00000600", []string{"\U0000200D", "\U00000600"}},
+	{"\U0000200D\U00000308\U00000600", []string{"\U0000200D\U00000308", "\U00000600"}},
+	{"\U0000200D\U00000903", []string{"\U0000200D\U00000903"}},
+	{"\U0000200D\U00000308\U00000903", []string{"\U0000200D\U00000308\U00000903"}},
+	{"\U0000200D\U00001100", []string{"\U0000200D", "\U00001100"}},
+	{"\U0000200D\U00000308\U00001100", []string{"\U0000200D\U00000308", "\U00001100"}},
+	{"\U0000200D\U00001160", []string{"\U0000200D", "\U00001160"}},
+	{"\U0000200D\U00000308\U00001160", []string{"\U0000200D\U00000308", "\U00001160"}},
+	{"\U0000200D\U000011A8", []string{"\U0000200D", "\U000011A8"}},
+	{"\U0000200D\U00000308\U000011A8", []string{"\U0000200D\U00000308", "\U000011A8"}},
+	{"\U0000200D\U0000AC00", []string{"\U0000200D", "\U0000AC00"}},
+	{"\U0000200D\U00000308\U0000AC00", []string{"\U0000200D\U00000308", "\U0000AC00"}},
+	{"\U0000200D\U0000AC01", []string{"\U0000200D", "\U0000AC01"}},
+	{"\U0000200D\U00000308\U0000AC01", []string{"\U0000200D\U00000308", "\U0000AC01"}},
+	{"\U0000200D\U0000231A", []string{"\U0000200D", "\U0000231A"}},
+	{"\U0000200D\U00000308\U0000231A", []string{"\U0000200D\U00000308", "\U0000231A"}},
+	{"\U0000200D\U00000300", []string{"\U0000200D\U00000300"}},
+	{"\U0000200D\U00000308\U00000300", []string{"\U0000200D\U00000308\U00000300"}},
+	{"\U0000200D\U0000200D", []string{"\U0000200D\U0000200D"}},
+	{"\U0000200D\U00000308\U0000200D", []string{"\U0000200D\U00000308\U0000200D"}},
+	{"\U0000200D\U00000378", []string{"\U0000200D", "\U00000378"}},
+	{"\U0000200D\U00000308\U00000378", []string{"\U0000200D\U00000308", "\U00000378"}},
+	{"\U00000378\U00000020", []string{"\U00000378", "\U00000020"}},
+	{"\U00000378\U00000308\U00000020", []string{"\U00000378\U00000308", "\U00000020"}},
+	{"\U00000378\U0000000D", []string{"\U00000378", "\U0000000D"}},
+	{"\U00000378\U00000308\U0000000D", []string{"\U00000378\U00000308", "\U0000000D"}},
+	{"\U00000378\U0000000A", []string{"\U00000378", "\U0000000A"}},
+	{"\U00000378\U00000308\U0000000A", []string{"\U00000378\U00000308", "\U0000000A"}},
+	{"\U00000378\U00000001", []string{"\U00000378", "\U00000001"}},
+	{"\U00000378\U00000308\U00000001", []string{"\U00000378\U00000308", "\U00000001"}},
+	{"\U00000378\U0000034F", []string{"\U00000378\U0000034F"}},
+	{"\U00000378\U00000308\U0000034F", []string{"\U00000378\U00000308\U0000034F"}},
+	{"\U00000378\U0001F1E6", []string{"\U00000378", "\U0001F1E6"}},
+	{"\U00000378\U00000308\U0001F1E6", []string{"\U00000378\U00000308", "\U0001F1E6"}},
+	{"\U00000378\U00000600", []string{"\U00000378", "\U00000600"}},
+	{"\U00000378\U00000308\U00000600", []string{"\U00000378\U00000308", "\U00000600"}},
+	{"\U00000378\U00000903", []string{"\U00000378\U00000903"}},
+	{"\U00000378\U00000308\U00000903", []string{"\U00000378\U00000308\U00000903"}},
+	{"\U00000378\U00001100", []string{"\U00000378", "\U00001100"}},
+	{"\U00000378\U00000308\U00001100", []string{"\U00000378\U00000308", "\U00001100"}},
+	{"\U00000378\U00001160", []string{"\U00000378", "\U00001160"}},
+	{"\U00000378\U00000308\U00001160", []string{"\U00000378\U00000308", "\U00001160"}},
+	{"\U00000378\U000011A8", []string{"\U00000378", "\U000011A8"}},
+	{"\U00000378\U00000308\U000011A8", []string{"\U00000378\U00000308", "\U000011A8"}},
+	{"\U00000378\U0000AC00", []string{"\U00000378", "\U0000AC00"}},
+	{"\U00000378\U00000308\U0000AC00", []string{"\U00000378\U00000308", "\U0000AC00"}},
+	{"\U00000378\U0000AC01", []string{"\U00000378", "\U0000AC01"}},
+	{"\U00000378\U00000308\U0000AC01", []string{"\U00000378\U00000308", "\U0000AC01"}},
+	{"\U00000378\U0000231A", []string{"\U00000378", "\U0000231A"}},
+	{"\U00000378\U00000308\U0000231A", []string{"\U00000378\U00000308", "\U0000231A"}},
+	{"\U00000378\U00000300", []string{"\U00000378\U00000300"}},
+	{"\U00000378\U00000308\U00000300", []string{"\U00000378\U00000308\U00000300"}},
+	{"\U00000378\U0000200D", []string{"\U00000378\U0000200D"}},
+	{"\U00000378\U00000308\U0000200D", []string{"\U00000378\U00000308\U0000200D"}},
+	{"\U00000378\U00000378", []string{"\U00000378", "\U00000378"}},
+	{"\U00000378\U00000308\U00000378", []string{"\U00000378\U00000308", "\U00000378"}},
+	{"\U0000000D\U0000000A\U00000061\U0000000A\U00000308", []string{"\U0000000D\U0000000A", "\U00000061", "\U0000000A", "\U00000308"}},
+	{"\U00000061\U00000308", []string{"\U00000061\U00000308"}},
+	{"\U00000020\U0000200D\U00000646", []string{"\U00000020\U0000200D", "\U00000646"}},
+	{"\U00000646\U0000200D\U00000020", []string{"\U00000646\U0000200D", "\U00000020"}},
+	{"\U00001100\U00001100", []string{"\U00001100\U00001100"}},
+	{"\U0000AC00\U000011A8\U00001100", []string{"\U0000AC00\U000011A8", "\U00001100"}},
+	{"\U0000AC01\U000011A8\U00001100", []string{"\U0000AC01\U000011A8", "\U00001100"}},
+	{"\U0001F1E6\U0001F1E7\U0001F1E8\U00000062", []string{"\U0001F1E6\U0001F1E7", "\U0001F1E8", "\U00000062"}},
+	{"\U00000061\U0001F1E6\U0001F1E7\U0001F1E8\U00000062", []string{"\U00000061", "\U0001F1E6\U0001F1E7", "\U0001F1E8", "\U00000062"}},
+	{"\U00000061\U0001F1E6\U0001F1E7\U0000200D\U0001F1E8\U00000062", []string{"\U00000061", "\U0001F1E6\U0001F1E7\U0000200D", "\U0001F1E8", "\U00000062"}},
+	{"\U00000061\U0001F1E6\U0000200D\U0001F1E7\U0001F1E8\U00000062", []string{"\U00000061", "\U0001F1E6\U0000200D", "\U0001F1E7\U0001F1E8", "\U00000062"}},
+	{"\U00000061\U0001F1E6\U0001F1E7\U0001F1E8\U0001F1E9\U00000062", []string{"\U00000061", "\U0001F1E6\U0001F1E7", "\U0001F1E8\U0001F1E9", "\U00000062"}},
+	{"\U00000061\U0000200D", []string{"\U00000061\U0000200D"}},
+	{"\U00000061\U00000308\U00000062", []string{"\U00000061\U00000308", "\U00000062"}},
+	{"\U00000061\U00000903\U00000062", []string{"\U00000061\U00000903", "\U00000062"}},
+	{"\U00000061\U00000600\U00000062", []string{"\U00000061", "\U00000600\U00000062"}},
+	{"\U0001F476\U0001F3FF\U0001F476", []string{"\U0001F476\U0001F3FF", "\U0001F476"}},
+	{"\U00000061\U0001F3FF\U0001F476", []string{"\U00000061\U0001F3FF", "\U0001F476"}},
+	{"\U00000061\U0001F3FF\U0001F476\U0000200D\U0001F6D1", []string{"\U00000061\U0001F3FF", "\U0001F476\U0000200D\U0001F6D1"}},
+	{"\U0001F476\U0001F3FF\U00000308\U0000200D\U0001F476\U0001F3FF", []string{"\U0001F476\U0001F3FF\U00000308\U0000200D\U0001F476\U0001F3FF"}},
+	{"\U0001F6D1\U0000200D\U0001F6D1", []string{"\U0001F6D1\U0000200D\U0001F6D1"}},
+	{"\U00000061\U0000200D\U0001F6D1", []string{"\U00000061\U0000200D", "\U0001F6D1"}},
+	{"\U00002701\U0000200D\U00002701", []string{"\U00002701\U0000200D\U00002701"}},
+	{"\U00000061\U0000200D\U00002701", []string{"\U00000061\U0000200D", "\U00002701"}},
+}
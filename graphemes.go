@@ -0,0 +1,215 @@
+package Text
+
+import "sort"
+
+// Grapheme cluster break property of a rune, see UAX #29
+type graphemeProperty uint8
+
+const (
+	gbOther graphemeProperty = iota
+	gbCR
+	gbLF
+	gbControl
+	gbExtend
+	gbZWJ
+	gbRegionalIndicator
+	gbPrepend
+	gbSpacingMark
+	gbL
+	gbV
+	gbT
+	gbLV
+	gbLVT
+	gbExtendedPictographic
+)
+
+type graphemeRange struct {
+	lo, hi   rune
+	property graphemeProperty
+}
+
+// Returns the number of grapheme clusters, the characters a user perceives.
+// "é" written as 'e' and a combining accent, a flag or a family emoji each count as one.
+func (s *StringBuilder) GraphemeCount() int {
+	count := 0
+	text := s.runes()
+	for i := 0; i < len(text); i = graphemeEnd(text, i) {
+		count++
+	}
+
+	return count
+}
+
+// Returns the grapheme cluster with the given index
+func (s *StringBuilder) GraphemeAt(index int) (string, error) {
+	text := s.runes()
+	for i, n := 0, 0; i < len(text); n++ {
+		end := graphemeEnd(text, i)
+		if n == index {
+			return string(text[i:end]), nil
+		}
+		i = end
+	}
+
+	return "", checkIndex("GraphemeAt", index, s.GraphemeCount(), false)
+}
+
+// Returns the grapheme clusters from start (inclusive) to end (exclusive). Unlike Substring it never splits a cluster.
+func (s *StringBuilder) SubstringGraphemes(start, end int) (string, error) {
+	offsets := graphemeOffsets(s.runes())
+	if err := checkBounds("SubstringGraphemes", start, end, len(offsets)-1); err != nil {
+		return "", err
+	}
+
+	return string(s.data[offsets[start]:offsets[end]]), nil
+}
+
+// Reverses the order of the grapheme clusters. Unlike Reverse it keeps combining marks, flags
+// and emoji sequences intact.
+func (s *StringBuilder) ReverseGraphemes() *StringBuilder {
+	text := s.runes()
+	offsets := graphemeOffsets(text)
+	if len(offsets) <= 2 {
+		return s
+	}
+
+	reversed := make([]rune, 0, len(text))
+	for i := len(offsets) - 1; i > 0; i-- {
+		reversed = append(reversed, text[offsets[i-1]:offsets[i]]...)
+	}
+	// The length stays the same, so this can't exceed the capacity
+	s.replaceRanges([]replacement{{0, len(text), reversed}}, ChangeReverse)
+
+	return s
+}
+
+// Shortens the text to at most n grapheme clusters. If something is cut off, the ellipsis is appended
+// and counts towards n. If the ellipsis alone is longer than n, the text is cut to n clusters without it.
+// A cluster is never split.
+func (s *StringBuilder) TruncateGraphemes(n int, ellipsis string) *StringBuilder {
+	offsets := graphemeOffsets(s.runes())
+	if n < 0 {
+		n = 0
+	}
+	if len(offsets)-1 <= n {
+		return s
+	}
+
+	suffix := []rune(ellipsis)
+	keep := n - (len(graphemeOffsets(suffix)) - 1)
+	if keep < 0 {
+		keep, suffix, ellipsis = n, nil, ""
+	}
+
+	start := offsets[keep]
+	if added := len(suffix) - (s.position - start); added > 0 {
+		if err := s.reserve(added); err != nil {
+			s.setErr(err)
+			return s
+		}
+	}
+
+	s.beginEdit()
+	defer s.endEdit()
+
+	s.Remove(start, s.position-start)
+	s.Append(ellipsis)
+
+	return s
+}
+
+// Returns the rune offsets at which the grapheme clusters start, followed by the length of the text
+func graphemeOffsets(text []rune) []int {
+	offsets := make([]int, 0, len(text)+1)
+	for i := 0; i < len(text); i = graphemeEnd(text, i) {
+		offsets = append(offsets, i)
+	}
+
+	return append(offsets, len(text))
+}
+
+// Returns the end of the grapheme cluster which starts at start, following the rules of UAX #29
+func graphemeEnd(text []rune, start int) int {
+	if start >= len(text) {
+		return len(text)
+	}
+
+	prev := graphemePropertyOf(text[start])
+	// Whether the cluster ends with Extended_Pictographic Extend*, or with that and a ZWJ for GB11
+	emoji := prev == gbExtendedPictographic
+	emojiZWJ := false
+	// Number of regional indicators in a row at the end of the cluster
+	regional := 0
+	if prev == gbRegionalIndicator {
+		regional = 1
+	}
+
+	for i := start + 1; i < len(text); i++ {
+		next := graphemePropertyOf(text[i])
+		if graphemeBreak(prev, next, emojiZWJ, regional) {
+			return i
+		}
+
+		emojiZWJ = emoji && next == gbZWJ
+		emoji = next == gbExtendedPictographic || emoji && next == gbExtend
+		if next == gbRegionalIndicator {
+			regional++
+		} else {
+			regional = 0
+		}
+		prev = next
+	}
+
+	return len(text)
+}
+
+// Reports whether there is a break between runes with the properties prev and next.
+// emojiZWJ tells whether prev is a ZWJ behind Extended_Pictographic Extend*, regional counts the
+// regional indicators in a row up to prev.
+func graphemeBreak(prev, next graphemeProperty, emojiZWJ bool, regional int) bool {
+	switch {
+	case prev == gbCR && next == gbLF: // GB3
+		return false
+	case prev == gbControl || prev == gbCR || prev == gbLF: // GB4
+		return true
+	case next == gbControl || next == gbCR || next == gbLF: // GB5
+		return true
+	case prev == gbL && (next == gbL || next == gbV || next == gbLV || next == gbLVT): // GB6
+		return false
+	case (prev == gbLV || prev == gbV) && (next == gbV || next == gbT): // GB7
+		return false
+	case (prev == gbLVT || prev == gbT) && next == gbT: // GB8
+		return false
+	case next == gbExtend || next == gbZWJ || next == gbSpacingMark: // GB9, GB9a
+		return false
+	case prev == gbPrepend: // GB9b
+		return false
+	case prev == gbZWJ && next == gbExtendedPictographic: // GB11
+		return !emojiZWJ
+	case prev == gbRegionalIndicator && next == gbRegionalIndicator: // GB12, GB13
+		return regional%2 == 0
+	}
+
+	return true // GB999
+}
+
+func graphemePropertyOf(r rune) graphemeProperty {
+	if r < 0x7F {
+		switch {
+		case r == '\r':
+			return gbCR
+		case r == '\n':
+			return gbLF
+		case r < 0x20:
+			return gbControl
+		}
+		return gbOther
+	}
+
+	i := sort.Search(len(graphemeBreakTable), func(i int) bool { return graphemeBreakTable[i].hi >= r })
+	if i < len(graphemeBreakTable) && graphemeBreakTable[i].lo <= r {
+		return graphemeBreakTable[i].property
+	}
+
+	return gbOther
+}
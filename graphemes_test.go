@@ -0,0 +1,145 @@
+package Text
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestGraphemeBreakTestCases(t *testing.T) {
+	for _, tt := range graphemeBreakTests {
+		s := NewStringBuilderFromString(tt.text)
+
+		var got []string
+		for i := 0; i < s.GraphemeCount(); i++ {
+			cluster, _ := s.GraphemeAt(i)
+			got = append(got, cluster)
+		}
+
+		if !reflect.DeepEqual(got, tt.clusters) {
+			t.Errorf("Clusters of %+q are %+q, want %+q", tt.text, got, tt.clusters)
+		}
+	}
+}
+
+func TestGraphemeCount(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want int
+	}{
+		{"Empty", "", 0},
+		{"ASCII", "Hello", 5},
+		{"Combining mark", "Café", 4},
+		{"CRLF", "a\r\nb", 3},
+		{"Flags", "🇩🇪🇫🇷", 2},
+		{"ZWJ family", "👨‍👩‍👧‍👦!", 2},
+		{"Skin tone", "👍🏽", 1},
+		{"Hangul", "각", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewStringBuilderFromString(tt.text)
+
+			if got := s.GraphemeCount(); got != tt.want {
+				t.Errorf("GraphemeCount() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGraphemeAtOutOfRange(t *testing.T) {
+	s := NewStringBuilderFromString("é")
+
+	if _, err := s.GraphemeAt(1); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("GraphemeAt(1) should fail with ErrIndexOutOfRange, got %v", err)
+	}
+	if _, err := s.GraphemeAt(-1); !errors.Is(err, ErrNegativeIndex) {
+		t.Errorf("GraphemeAt(-1) should fail with ErrNegativeIndex, got %v", err)
+	}
+}
+
+func TestReverseGraphemes(t *testing.T) {
+	s := NewStringBuilderFromString("é🇩🇪👨‍👩‍👧x")
+
+	s.ReverseGraphemes()
+
+	if want := "x👨‍👩‍👧🇩🇪é"; s.ToString() != want {
+		t.Errorf("Got %+q, want %+q", s.ToString(), want)
+	}
+}
+
+func TestReverseGraphemesCanBeUndone(t *testing.T) {
+	s := NewStringBuilderFromString("ab🇩🇪", WithHistory(10))
+
+	s.ReverseGraphemes()
+	s.Undo()
+
+	if s.ToString() != "ab🇩🇪" {
+		t.Errorf("Got %q after Undo, want %q", s.ToString(), "ab🇩🇪")
+	}
+}
+
+func TestSubstringGraphemes(t *testing.T) {
+	s := NewStringBuilderFromString("José 👍🏽 ok")
+
+	got, err := s.SubstringGraphemes(3, 6)
+
+	if err != nil || got != "é 👍🏽" {
+		t.Errorf("SubstringGraphemes(3, 6) = %+q, %v, want %+q", got, err, "é 👍🏽")
+	}
+	if _, err := s.SubstringGraphemes(3, 10); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("SubstringGraphemes(3, 10) should fail with ErrIndexOutOfRange, got %v", err)
+	}
+}
+
+func TestTruncateGraphemes(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		n        int
+		ellipsis string
+		want     string
+	}{
+		{"Short enough", "Hi 👋", 4, "…", "Hi 👋"},
+		{"Cut behind emoji", "Hi 👨‍👩‍👧 family", 5, "…", "Hi 👨‍👩‍👧…"},
+		{"Cut in front of emoji", "Hello 🇩🇪!", 7, "...", "Hell..."},
+		{"Combining mark stays", "Cafés", 5, "…", "Cafés"},
+		{"Combining mark", "Cafés and more", 5, "…", "Café…"},
+		{"Ellipsis too long", "Hello", 2, "...", "He"},
+		{"Without ellipsis", "🇩🇪🇫🇷🇮🇹", 2, "", "🇩🇪🇫🇷"},
+		{"Zero", "Hello", 0, "…", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewStringBuilderFromString(tt.text)
+
+			s.TruncateGraphemes(tt.n, tt.ellipsis)
+
+			if s.ToString() != tt.want {
+				t.Errorf("Got %+q, want %+q", s.ToString(), tt.want)
+			}
+		})
+	}
+}
+
+func TestTruncateGraphemesRespectsMaxCapacity(t *testing.T) {
+	s := NewStringBuilderFromString("Hello!", WithMaxCapacity(6))
+
+	// Two graphemes, but four runes
+	s.TruncateGraphemes(5, "e\u0301e\u0301")
+
+	if !errors.Is(s.Err(), ErrCapacityExceeded) || s.ToString() != "Hello!" {
+		t.Errorf("Got %q with error %v, want an unchanged content and ErrCapacityExceeded", s.ToString(), s.Err())
+	}
+}
+
+func TestConcurrentStringBuilderGraphemes(t *testing.T) {
+	s := NewConcurrentStringBuilderFromString("a🇩🇪")
+
+	s.ReverseGraphemes()
+
+	if s.ToString() != "🇩🇪a" || s.GraphemeCount() != 2 {
+		t.Errorf("Got %q with %v graphemes, want %q with 2", s.ToString(), s.GraphemeCount(), "🇩🇪a")
+	}
+}
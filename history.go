@@ -0,0 +1,215 @@
+package Text
+
+// Keeps the edits of a StringBuilder so they can be undone and redone
+type history struct {
+	undo  []historyStep
+	redo  []historyStep
+	depth int
+	// Edits of the open group
+	group      historyStep
+	groupDepth int
+	// Set while undoing or redoing, so the replayed edits are not recorded again
+	replaying bool
+}
+
+// All edits which are undone and redone together
+type historyStep []edit
+
+// Records the edits of the StringBuilder so they can be undone with Undo, keeping up to depth undo steps
+func WithHistory(depth int) Option {
+	return func(s *StringBuilder) {
+		s.EnableHistory(depth)
+	}
+}
+
+// Starts recording edits so they can be undone with Undo. Every call which changes the content becomes one
+// undo step, unless it is part of a group. Only the last depth steps are kept, older ones are dropped.
+// Calling it again only changes the depth.
+func (s *StringBuilder) EnableHistory(depth int) *StringBuilder {
+	if s.history == nil {
+		s.history = &history{}
+	}
+	s.history.depth = depth
+	s.history.trim()
+
+	return s
+}
+
+// Stops recording edits and drops the whole history
+func (s *StringBuilder) DisableHistory() *StringBuilder {
+	s.history = nil
+
+	return s
+}
+
+// Bundles all following edits until the matching EndGroup into one undo step. Groups can be nested,
+// the outermost group forms the step.
+func (s *StringBuilder) BeginGroup() *StringBuilder {
+	if s.history != nil {
+		s.history.groupDepth++
+	}
+
+	return s
+}
+
+// Closes the group opened by BeginGroup
+func (s *StringBuilder) EndGroup() *StringBuilder {
+	h := s.history
+	if h == nil || h.groupDepth == 0 {
+		return s
+	}
+
+	h.groupDepth--
+	if h.groupDepth == 0 && len(h.group) > 0 {
+		h.push(h.group)
+		h.group = nil
+	}
+
+	return s
+}
+
+// Reports whether there is an edit which can be undone
+func (s *StringBuilder) CanUndo() bool {
+	return s.history != nil && (len(s.history.undo) > 0 || len(s.history.group) > 0)
+}
+
+// Reports whether there is an undone edit which can be redone
+func (s *StringBuilder) CanRedo() bool {
+	return s.history != nil && len(s.history.redo) > 0 && len(s.history.group) == 0
+}
+
+// Reverts the last undo step and reports whether there was one. Open groups are closed first.
+func (s *StringBuilder) Undo() bool {
+	h := s.history
+	if h == nil {
+		return false
+	}
+	for h.groupDepth > 0 {
+		s.EndGroup()
+	}
+	if len(h.undo) == 0 {
+		return false
+	}
+
+	step := h.undo[len(h.undo)-1]
+	h.undo = h.undo[:len(h.undo)-1]
+
+	h.replaying = true
+	for i := len(step) - 1; i >= 0; i-- {
+		if err := s.applyEdit(step[i], true, ChangeUndo); err != nil {
+			s.setErr(err)
+		}
+	}
+	h.replaying = false
+	s.fitMaxCapacity()
+
+	h.redo = append(h.redo, step)
+
+	return true
+}
+
+// Applies the last undone step again and reports whether there was one
+func (s *StringBuilder) Redo() bool {
+	h := s.history
+	if h == nil || len(h.redo) == 0 || h.groupDepth > 0 {
+		return false
+	}
+
+	step := h.redo[len(h.redo)-1]
+	h.redo = h.redo[:len(h.redo)-1]
+
+	h.replaying = true
+	for _, e := range step {
+		if err := s.applyEdit(e, false, ChangeRedo); err != nil {
+			s.setErr(err)
+		}
+	}
+	h.replaying = false
+	s.fitMaxCapacity()
+
+	h.undo = append(h.undo, step)
+
+	return true
+}
+
+func (s *StringBuilder) applyEdit(e edit, backwards bool, kind ChangeKind) error {
+	if e.reversed {
+		s.reverse()
+		s.record(edit{kind: kind, reversed: true})
+		return nil
+	}
+
+	from, to := e.removed, e.inserted
+	if backwards {
+		from, to = to, from
+	}
+
+	return s.replaceRanges([]replacement{{e.start, e.start + len(from), to}}, kind)
+}
+
+// Reports whether an undo, redo or rollback is restoring an earlier state
+func (s *StringBuilder) restoring() bool {
+	return s.history != nil && s.history.replaying || s.journal != nil && s.journal.rollingBack
+}
+
+// Reports whether edits have to be recorded right now
+func (s *StringBuilder) recording() bool {
+	return s.history != nil && !s.history.replaying
+}
+
+// Adds an edit to the history
+func (s *StringBuilder) recordHistory(e edit) {
+	if !s.recording() {
+		return
+	}
+
+	h := s.history
+	if h.groupDepth == 0 {
+		h.push(historyStep{e})
+		return
+	}
+
+	// Appends right behind each other become one edit, e.g. all parts of an AppendFormat
+	if n := len(h.group); n > 0 {
+		last := &h.group[n-1]
+		if !last.reversed && !e.reversed && len(last.removed) == 0 && len(e.removed) == 0 &&
+			last.start+len(last.inserted) == e.start {
+			last.inserted = append(last.inserted[:len(last.inserted):len(last.inserted)], e.inserted...)
+			return
+		}
+	}
+	h.group = append(h.group, e)
+}
+
+// Opens an internal group, so a call made of several edits becomes one undo step
+func (s *StringBuilder) beginEdit() {
+	if s.recording() {
+		s.history.groupDepth++
+	}
+}
+
+func (s *StringBuilder) endEdit() {
+	if s.recording() {
+		s.EndGroup()
+	}
+}
+
+func (h *history) push(step historyStep) {
+	h.undo = append(h.undo, step)
+	h.redo = nil
+	h.trim()
+}
+
+// Drops the oldest steps above the depth
+func (h *history) trim() {
+	if h.depth < 0 {
+		h.depth = 0
+	}
+	if excess := len(h.undo) - h.depth; excess > 0 {
+		copy(h.undo, h.undo[excess:])
+		for i := h.depth; i < len(h.undo); i++ {
+			h.undo[i] = nil
+		}
+		h.undo = h.undo[:h.depth]
+	}
+}
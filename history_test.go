@@ -0,0 +1,145 @@
+package Text
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestUndoRevertsEveryKindOfEdit(t *testing.T) {
+	tests := []struct {
+		name string
+		edit func(s *StringBuilder)
+	}{
+		{"Append", func(s *StringBuilder) { s.Append(" and more") }},
+		{"AppendLine", func(s *StringBuilder) { s.AppendLine("!") }},
+		{"AppendRune", func(s *StringBuilder) { s.AppendRune('ö') }},
+		{"AppendList", func(s *StringBuilder) { s.AppendList([]string{"a", "b"}) }},
+		{"AppendFormat", func(s *StringBuilder) { s.AppendFormat("{0,5}|{1:N2}", "x", 1234.5) }},
+		{"AppendFloat", func(s *StringBuilder) { s.AppendFloat(1.5, "F2") }},
+		{"Write", func(s *StringBuilder) { s.Write([]byte("Grüße")) }},
+		{"ReadFrom", func(s *StringBuilder) { s.ReadFrom(strings.NewReader(strings.Repeat("x", 10000))) }},
+		{"Insert", func(s *StringBuilder) { s.Insert(5, " my dear") }},
+		{"Remove", func(s *StringBuilder) { s.Remove(2, 5) }},
+		{"Clear", func(s *StringBuilder) { s.Clear() }},
+		{"Replace", func(s *StringBuilder) { s.Replace("l", "LLL") }},
+		{"ReplaceLast", func(s *StringBuilder) { s.ReplaceLast("l", "") }},
+		{"ReplaceRune", func(s *StringBuilder) { s.ReplaceRune('l', 'x') }},
+		{"Trim", func(s *StringBuilder) { s.Trim() }},
+		{"TrimStart", func(s *StringBuilder) { s.TrimStart(' ', 'H') }},
+		{"Reverse", func(s *StringBuilder) { s.Reverse() }},
+		{"SetRuneAt", func(s *StringBuilder) { s.SetRuneAt(0, 'J') }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			const text = "  Hello World, hello Gophers  "
+			s := NewStringBuilderFromString(text, WithHistory(10))
+			tt.edit(s)
+			edited := s.ToString()
+
+			if !s.Undo() {
+				t.Fatalf("StringBuilder.Undo() = false, want true")
+			}
+			if got := s.ToString(); got != text {
+				t.Errorf("StringBuilder after Undo = %q, want %q", got, text)
+			}
+			if !s.Redo() {
+				t.Fatalf("StringBuilder.Redo() = false, want true")
+			}
+			if got := s.ToString(); got != edited {
+				t.Errorf("StringBuilder after Redo = %q, want %q", got, edited)
+			}
+			if s.CanRedo() {
+				t.Errorf("StringBuilder.CanRedo() = true after redoing everything")
+			}
+		})
+	}
+}
+
+func TestUndoStepsBackOneCallAtATime(t *testing.T) {
+	s := (&StringBuilder{}).EnableHistory(10)
+
+	s.Append("Hello")
+	s.Append(" World")
+	s.Insert(5, ",")
+
+	want := []string{"Hello World", "Hello", ""}
+	for _, expected := range want {
+		s.Undo()
+		if got := s.ToString(); got != expected {
+			t.Errorf("StringBuilder after Undo = %q, want %q", got, expected)
+		}
+	}
+	if s.Undo() {
+		t.Errorf("StringBuilder.Undo() = true without any edit left")
+	}
+}
+
+func TestGroupIsUndoneAsOneStep(t *testing.T) {
+	s := NewStringBuilderFromString("Hello", WithHistory(10))
+
+	s.BeginGroup()
+	s.Append(" World")
+	s.BeginGroup()
+	s.Replace("o", "0")
+	s.EndGroup()
+	s.Insert(0, ">> ")
+	s.EndGroup()
+
+	if got := s.ToString(); got != ">> Hell0 W0rld" {
+		t.Fatalf("StringBuilder.ToString() = %q, want %q", got, ">> Hell0 W0rld")
+	}
+	s.Undo()
+	if got := s.ToString(); got != "Hello" {
+		t.Errorf("StringBuilder after Undo = %q, want %q", got, "Hello")
+	}
+	s.Redo()
+	if got := s.ToString(); got != ">> Hell0 W0rld" {
+		t.Errorf("StringBuilder after Redo = %q, want %q", got, ">> Hell0 W0rld")
+	}
+}
+
+func TestNewEditDropsRedo(t *testing.T) {
+	s := NewStringBuilderFromString("Hello", WithHistory(10))
+	s.Append(" World")
+	s.Undo()
+
+	s.Append("!")
+
+	if s.CanRedo() || s.Redo() {
+		t.Errorf("StringBuilder.Redo() is possible after a new edit")
+	}
+}
+
+func TestHistoryDepthDropsOldestSteps(t *testing.T) {
+	s := NewStringBuilder(0, WithHistory(2))
+
+	s.Append("a").Append("b").Append("c")
+
+	for s.Undo() {
+	}
+	if got := s.ToString(); got != "a" {
+		t.Errorf("StringBuilder after undoing everything = %q, want %q", got, "a")
+	}
+}
+
+func TestEditsAreNotRecordedWithoutHistory(t *testing.T) {
+	s := NewStringBuilderFromString("Hello")
+
+	s.Append(" World").BeginGroup().Remove(0, 1)
+	s.EndGroup()
+
+	if s.CanUndo() || s.Undo() {
+		t.Errorf("StringBuilder.Undo() is possible without history")
+	}
+}
+
+func TestDisableHistory(t *testing.T) {
+	s := NewStringBuilderFromString("Hello", WithHistory(10))
+	s.Append(" World")
+
+	s.DisableHistory()
+
+	if s.Undo() || s.ToString() != "Hello World" {
+		t.Errorf("StringBuilder.Undo() changed the text after DisableHistory: %q", s.ToString())
+	}
+}
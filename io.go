@@ -0,0 +1,237 @@
+package Text
+
+import (
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// Size of the buffers used to move content between the StringBuilder and readers or writers
+const ioBufferSize = 4096
+
+// Implements the io.Writer interface so the StringBuilder can be used with fmt.Printf.
+// p is decoded as UTF-8, so multi-byte characters may be split over several calls: an incomplete rune
+// at the end of p is added as utf8.RuneError for every byte and replaced once the next Write completes it.
+// If the content was changed in between, the bytes stay utf8.RuneError. Completing the rune is a single
+// ChangeReplace of the utf8.RuneError runes with everything the Write added.
+func (s *StringBuilder) Write(p []byte) (int, error) {
+	if err := s.appendBytes(p); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// Implements the io.StringWriter interface. Returns the number of bytes of text
+func (s *StringBuilder) WriteString(text string) (int, error) {
+	if err := s.appendString(text); err != nil {
+		return 0, err
+	}
+
+	return len(text), nil
+}
+
+// Implements the io.ByteWriter interface. Bytes of a multi-byte character are collected
+// until the character is complete
+func (s *StringBuilder) WriteByte(c byte) error {
+	if c < utf8.RuneSelf && s.pending.len == 0 {
+		return s.appendRune(rune(c))
+	}
+
+	return s.appendBytes([]byte{c})
+}
+
+// Writes a single character and returns the number of bytes of its UTF-8 encoding
+func (s *StringBuilder) WriteRune(char rune) (int, error) {
+	var encoded [utf8.UTFMax]byte
+	if err := s.appendRune(char); err != nil {
+		return 0, err
+	}
+
+	return utf8.EncodeRune(encoded[:], char), nil
+}
+
+// Implements the io.ReaderFrom interface. Appends everything from r until io.EOF
+// and returns the number of bytes read. Like Write it completes an incomplete rune of the last Write.
+func (s *StringBuilder) ReadFrom(r io.Reader) (int64, error) {
+	// Room for the bytes of a rune which was split between two reads
+	buffer := make([]byte, utf8.UTFMax+ioBufferSize)
+	carried, removed := s.takePending(buffer)
+	from := s.beginAppend()
+	defer s.endResumed(from, removed)
+
+	var total int64
+	for {
+		n, err := r.Read(buffer[carried : carried+ioBufferSize])
+		chunk := buffer[:carried+n]
+		complete := completeUtf8Length(chunk)
+		if appendErr := s.appendUtf8(chunk[:complete]); appendErr != nil {
+			return total, appendErr
+		}
+		total += int64(n)
+		carried = copy(buffer, chunk[complete:])
+
+		if err != nil {
+			if appendErr := s.appendUtf8(buffer[:carried]); appendErr != nil {
+				return total, appendErr
+			}
+			s.holdPending(buffer[:carried])
+			if err == io.EOF {
+				return total, nil
+			}
+			return total, err
+		}
+	}
+}
+
+// Implements the io.WriterTo interface. Writes the content as UTF-8 to w
+// without creating a copy of the whole string first
+func (s *StringBuilder) WriteTo(w io.Writer) (int64, error) {
+	return writeRunesTo(w, s.runes())
+}
+
+// Writes the runes as UTF-8 to w in blocks of ioBufferSize bytes
+func writeRunesTo(w io.Writer, runes []rune) (int64, error) {
+	buffer := make([]byte, 0, ioBufferSize)
+	var total int64
+
+	for i := 0; i < len(runes); {
+		buffer = buffer[:0]
+		for ; i < len(runes) && len(buffer) <= ioBufferSize-utf8.UTFMax; i++ {
+			buffer = utf8.AppendRune(buffer, runes[i])
+		}
+
+		n, err := w.Write(buffer)
+		total += int64(n)
+		if err != nil {
+			return total, err
+		}
+		if n != len(buffer) {
+			return total, io.ErrShortWrite
+		}
+	}
+
+	return total, nil
+}
+
+// Returns a reader over the current content of the string builder. The reader implements
+// io.Reader, io.RuneScanner, io.Seeker and friends and is not affected by later changes
+// to the string builder.
+func (s *StringBuilder) NewReader() *strings.Reader {
+	return strings.NewReader(s.ToString())
+}
+
+// Bytes of an incomplete UTF-8 sequence at the end of the last Write. They are part of the content as
+// one utf8.RuneError per byte, so the next Write can decode them again together with its bytes.
+// Every other change of the content drops them, as the placeholders may not be the end of the content anymore.
+type pendingRune struct {
+	bytes [utf8.UTFMax]byte
+	len   int
+}
+
+// Appends p decoded as UTF-8 and continues an incomplete rune of the last Write.
+// An incomplete rune at the end of p is appended as utf8.RuneError and kept for the next call.
+func (s *StringBuilder) appendBytes(p []byte) error {
+	if s.pending.len > 0 {
+		combined := make([]byte, 0, s.pending.len+len(p))
+		combined = append(append(combined, s.pending.bytes[:s.pending.len]...), p...)
+		// The placeholders get replaced, so only the additional runes have to fit
+		if err := s.reserve(utf8.RuneCount(combined) - s.pending.len); err != nil {
+			return err
+		}
+		p = combined
+	}
+
+	_, removed := s.takePending(nil)
+	from := s.beginAppend()
+	err := s.appendUtf8(p)
+	s.endResumed(from, removed)
+	if err != nil {
+		return err
+	}
+	s.holdPending(p)
+
+	return nil
+}
+
+// Keeps the bytes of an incomplete rune at the end of the appended p for the next Write
+func (s *StringBuilder) holdPending(p []byte) {
+	if complete := completeUtf8Length(p); complete < len(p) {
+		s.pending.len = copy(s.pending.bytes[:], p[complete:])
+	}
+}
+
+// Forgets the incomplete rune of the last Write. Called by every change of the content except Write and ReadFrom.
+func (s *StringBuilder) dropPending() {
+	s.pending.len = 0
+}
+
+// Takes the placeholders of an incomplete rune off the end of the content without recording it and copies its
+// bytes into p, so they can be decoded again together with the following bytes. Returns the number of bytes
+// and the removed placeholders, which endResumed records together with what replaces them.
+func (s *StringBuilder) takePending(p []byte) (int, []rune) {
+	if s.pending.len == 0 {
+		return 0, nil
+	}
+
+	n := copy(p, s.pending.bytes[:s.pending.len])
+	start := s.position - s.pending.len
+	removed := make([]rune, s.pending.len)
+	copy(removed, s.data[start:s.position])
+	s.position = start
+	s.pending.len = 0
+
+	return n, removed
+}
+
+// Ends an append started after takePending. The removed placeholders and the runes appended behind from
+// are recorded as one replacement, so observers get one event and one Undo reverts the whole Write.
+func (s *StringBuilder) endResumed(from int, removed []rune) {
+	if len(removed) == 0 {
+		s.endAppend(from)
+		return
+	}
+
+	s.batching--
+	if !s.needsText() {
+		s.invalidateLines(from)
+		s.moveMarkers(from, len(removed), s.position-from, false)
+		return
+	}
+	inserted := make([]rune, s.position-from)
+	copy(inserted, s.data[from:s.position])
+	s.record(edit{kind: ChangeReplace, start: from, removed: removed, inserted: inserted})
+}
+
+// Appends the runes of the UTF-8 encoded p. Invalid bytes become utf8.RuneError.
+func (s *StringBuilder) appendUtf8(p []byte) error {
+	if err := s.reserveBytes(p); err != nil {
+		return err
+	}
+	s.unshare(s.position)
+	s.dropPending()
+	from := s.position
+	for i := 0; i < len(p); {
+		r, size := utf8.DecodeRune(p[i:])
+		s.data[s.position] = r
+		s.position++
+		i += size
+	}
+	s.recordAppend(from)
+
+	return nil
+}
+
+// Returns the length of p without an incomplete rune at its end
+func completeUtf8Length(p []byte) int {
+	for i := len(p) - 1; i >= 0 && i > len(p)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(p[i]) {
+			if !utf8.FullRune(p[i:]) {
+				return i
+			}
+			break
+		}
+	}
+
+	return len(p)
+}
@@ -0,0 +1,288 @@
+package Text
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"text/template"
+)
+
+func TestWriteReturnsByteCount(t *testing.T) {
+	s := &StringBuilder{}
+
+	n, err := s.Write([]byte("Hällo 汉字"))
+
+	if err != nil || n != 13 {
+		t.Errorf("StringBuilder.Write() = %v, %v, want %v, nil", n, err, 13)
+	}
+	if got := s.Len(); got != 8 {
+		t.Errorf("StringBuilder.Len() = %v, want %v", got, 8)
+	}
+}
+
+func TestWriteWithSplitMultiByteCharacters(t *testing.T) {
+	const want = "Hällo 汉字 😀"
+	s := &StringBuilder{}
+
+	for _, b := range []byte(want) {
+		s.Write([]byte{b})
+	}
+
+	if got := s.ToString(); got != want {
+		t.Errorf("StringBuilder.Write() = %q, want %q", got, want)
+	}
+}
+
+func TestWriteByte(t *testing.T) {
+	const want = "aö字"
+	s := &StringBuilder{}
+
+	for _, b := range []byte(want) {
+		if err := s.WriteByte(b); err != nil {
+			t.Errorf("StringBuilder.WriteByte() threw an error: %v", err)
+		}
+	}
+
+	if got := s.ToString(); got != want {
+		t.Errorf("StringBuilder.WriteByte() = %q, want %q", got, want)
+	}
+}
+
+func TestWriteInvalidUtf8(t *testing.T) {
+	s := &StringBuilder{}
+
+	s.Write([]byte{'a', 0xff, 'b'})
+
+	if got := s.ToString(); got != "a�b" {
+		t.Errorf("StringBuilder.Write() = %q, want %q", got, "a�b")
+	}
+}
+
+func TestWriteKeepsOrderWithOtherAppends(t *testing.T) {
+	s := &StringBuilder{}
+
+	s.Write([]byte("a\xe2\x82"))
+	s.Append("x")
+	s.Write([]byte("\xac"))
+	s.AppendRune('y')
+
+	if got := s.ToString(); got != "a\uFFFD\uFFFDx\uFFFDy" {
+		t.Errorf("StringBuilder.ToString() = %+q, want %+q", got, "a\uFFFD\uFFFDx\uFFFDy")
+	}
+}
+
+func TestWriteAfterInsertDoesNotCompleteTheRune(t *testing.T) {
+	s := &StringBuilder{}
+
+	s.Write([]byte("ab\xe2\x82"))
+	s.SetRuneAt(3, 'x')
+	s.Insert(0, ">")
+	s.Write([]byte("\xac"))
+
+	if got := s.ToString(); got != ">ab\uFFFDx\uFFFD" {
+		t.Errorf("StringBuilder.ToString() = %+q, want %+q", got, ">ab\uFFFDx\uFFFD")
+	}
+}
+
+func TestWriteWithIncompleteRuneAtTheEnd(t *testing.T) {
+	s := &StringBuilder{}
+	var buffer bytes.Buffer
+
+	s.Write([]byte("trunc\xe2"))
+	s.WriteTo(&buffer)
+
+	if got := s.ToString(); got != "trunc\uFFFD" {
+		t.Errorf("StringBuilder.ToString() = %+q, want %+q", got, "trunc\uFFFD")
+	}
+	if got := s.Len(); got != 6 {
+		t.Errorf("StringBuilder.Len() = %v, want %v", got, 6)
+	}
+	if got := buffer.String(); got != "trunc\uFFFD" {
+		t.Errorf("StringBuilder.WriteTo() wrote %+q, want %+q", got, "trunc\uFFFD")
+	}
+}
+
+func TestWriteCompletesTheRuneWithEvents(t *testing.T) {
+	s := NewStringBuilder(0, WithHistory(10))
+	var events []ChangeEvent
+	s.OnChange(func(ev ChangeEvent) { events = append(events, ev) })
+
+	s.Write([]byte("\xe2\x82"))
+	s.Write([]byte("\xac!"))
+
+	want := []ChangeEvent{
+		{ChangeAppend, 0, 0, "", "\uFFFD\uFFFD"},
+		{ChangeReplace, 0, 2, "\uFFFD\uFFFD", "\u20AC!"},
+	}
+	if !reflect.DeepEqual(events, want) {
+		t.Errorf("Got events %+q, want %+q", events, want)
+	}
+	s.Undo()
+	if got := s.ToString(); got != "\uFFFD\uFFFD" {
+		t.Errorf("StringBuilder after Undo = %+q, want %+q", got, "\uFFFD\uFFFD")
+	}
+	s.Undo()
+	if got := s.ToString(); got != "" {
+		t.Errorf("StringBuilder after the second Undo = %+q, want %+q", got, "")
+	}
+}
+
+func TestWriteDoesNotCompleteAppendedPlaceholders(t *testing.T) {
+	s := NewStringBuilder(0)
+
+	s.Write([]byte("\xe2\x82"))
+	s.Remove(0, 2)
+	s.Append("\uFFFD\uFFFD")
+	s.Write([]byte("\xac"))
+
+	if got := s.ToString(); got != "\uFFFD\uFFFD\uFFFD" {
+		t.Errorf("StringBuilder.ToString() = %+q, want %+q", got, "\uFFFD\uFFFD\uFFFD")
+	}
+}
+
+func TestReadFromCompletesSplitRunes(t *testing.T) {
+	s := &StringBuilder{}
+
+	s.Write([]byte("H\xc3"))
+	s.ReadFrom(iotest.OneByteReader(strings.NewReader("\xa4llo 汉字 \xf0\x9f")))
+
+	if got := s.ToString(); got != "Hällo 汉字 \uFFFD\uFFFD" {
+		t.Errorf("StringBuilder.ToString() = %+q, want %+q", got, "Hällo 汉字 \uFFFD\uFFFD")
+	}
+	s.Write([]byte("\x98\x80"))
+	if got := s.ToString(); got != "Hällo 汉字 😀" {
+		t.Errorf("StringBuilder.ToString() = %+q, want %+q", got, "Hällo 汉字 😀")
+	}
+}
+
+func TestReadFromCompletesTheRuneWithOneEvent(t *testing.T) {
+	s := NewStringBuilderFromString("H")
+	s.Write([]byte("\xc3"))
+	var events []ChangeEvent
+	s.OnChange(func(ev ChangeEvent) { events = append(events, ev) })
+
+	s.ReadFrom(iotest.OneByteReader(strings.NewReader("\xa4llo")))
+
+	want := []ChangeEvent{{ChangeReplace, 1, 2, "\uFFFD", "\u00E4llo"}}
+	if !reflect.DeepEqual(events, want) {
+		t.Errorf("Got events %+q, want %+q", events, want)
+	}
+}
+
+func TestWriteStringAndWriteRune(t *testing.T) {
+	s := &StringBuilder{}
+
+	n1, _ := s.WriteString("Hällo")
+	n2, _ := s.WriteRune('字')
+
+	if n1 != 6 || n2 != 3 {
+		t.Errorf("StringBuilder.WriteString() = %v, WriteRune() = %v, want %v, %v", n1, n2, 6, 3)
+	}
+	if got := s.ToString(); got != "Hällo字" {
+		t.Errorf("StringBuilder.ToString() = %q, want %q", got, "Hällo字")
+	}
+}
+
+func TestReadFrom(t *testing.T) {
+	want := strings.Repeat("Grüße 😀 ", 1000)
+	s := NewStringBuilderFromString(">")
+
+	n, err := s.ReadFrom(iotest.HalfReader(strings.NewReader(want)))
+
+	if err != nil || n != int64(len(want)) {
+		t.Errorf("StringBuilder.ReadFrom() = %v, %v, want %v, nil", n, err, len(want))
+	}
+	if got := s.ToString(); got != ">"+want {
+		t.Errorf("StringBuilder.ReadFrom() did not read the whole content")
+	}
+}
+
+func TestReadFromReturnsReaderError(t *testing.T) {
+	s := &StringBuilder{}
+	readErr := errors.New("broken")
+
+	if _, err := s.ReadFrom(iotest.ErrReader(readErr)); !errors.Is(err, readErr) {
+		t.Errorf("StringBuilder.ReadFrom() error = %v, want %v", err, readErr)
+	}
+}
+
+func TestWriteTo(t *testing.T) {
+	want := strings.Repeat("Grüße 😀 ", 1000)
+	s := NewStringBuilderFromString(want)
+	var buffer bytes.Buffer
+
+	n, err := s.WriteTo(&buffer)
+
+	if err != nil || n != int64(len(want)) {
+		t.Errorf("StringBuilder.WriteTo() = %v, %v, want %v, nil", n, err, len(want))
+	}
+	if got := buffer.String(); got != want {
+		t.Errorf("StringBuilder.WriteTo() did not write the whole content")
+	}
+}
+
+func TestIoCopyAndBufio(t *testing.T) {
+	const want = "Hällo Wörld"
+	source := NewStringBuilderFromString(want)
+	target := &StringBuilder{}
+
+	if _, err := io.Copy(target, source.NewReader()); err != nil {
+		t.Errorf("io.Copy() threw an error: %v", err)
+	}
+	writer := bufio.NewWriterSize(target, 16)
+	writer.WriteString(strings.Repeat("ö", 20))
+	if err := writer.Flush(); err != nil {
+		t.Errorf("bufio.Writer.Flush() threw an error: %v", err)
+	}
+
+	if got := target.ToString(); got != want+strings.Repeat("ö", 20) {
+		t.Errorf("StringBuilder = %q, want %q", got, want+strings.Repeat("ö", 20))
+	}
+}
+
+func TestTemplateAndJsonEncoder(t *testing.T) {
+	s := &StringBuilder{}
+	tmpl := template.Must(template.New("greeting").Parse("Hello {{.}}! "))
+
+	if err := tmpl.Execute(s, "Wörld"); err != nil {
+		t.Errorf("template.Execute() threw an error: %v", err)
+	}
+	if err := json.NewEncoder(s).Encode(map[string]string{"name": "Jürgen"}); err != nil {
+		t.Errorf("json.Encoder.Encode() threw an error: %v", err)
+	}
+
+	if got := s.ToString(); got != "Hello Wörld! {\"name\":\"Jürgen\"}\n" {
+		t.Errorf("StringBuilder = %q", got)
+	}
+}
+
+func TestNewReader(t *testing.T) {
+	s := NewStringBuilderFromString("äbc")
+	reader := s.NewReader()
+	s.Append("def")
+
+	r, size, _ := reader.ReadRune()
+	if r != 'ä' || size != 2 {
+		t.Errorf("Reader.ReadRune() = %q, %v, want %q, %v", r, size, 'ä', 2)
+	}
+	if err := reader.UnreadRune(); err != nil {
+		t.Errorf("Reader.UnreadRune() threw an error: %v", err)
+	}
+	if _, err := reader.Seek(2, io.SeekStart); err != nil {
+		t.Errorf("Reader.Seek() threw an error: %v", err)
+	}
+	rest, _ := io.ReadAll(reader)
+
+	if string(rest) != "bc" {
+		t.Errorf("Reader content = %q, want %q", rest, "bc")
+	}
+	if got := s.ToString(); got != "äbcdef" {
+		t.Errorf("StringBuilder = %q, want %q", got, "äbcdef")
+	}
+}
@@ -0,0 +1,139 @@
+package Text
+
+import "sort"
+
+// Start offsets of the lines of a StringBuilder. It is built lazily by the first line query
+// and extended on demand, so appends don't cost anything until the next query.
+type lineIndex struct {
+	// Offsets of all known line starts, starts[0] is always 0
+	starts []int
+	// Number of runes already scanned for line breaks
+	scanned int
+}
+
+// Returns the number of lines. A text without line breaks has one line, a line break at the end starts
+// an empty last line. "\n", "\r\n" and a lone "\r" end a line.
+func (s *StringBuilder) LineCount() int {
+	return len(s.lineStarts())
+}
+
+// Returns the 1-based line n without its line ending
+func (s *StringBuilder) Line(n int) (string, error) {
+	starts := s.lineStarts()
+	if err := checkLine("Line", n, len(starts)); err != nil {
+		return "", err
+	}
+
+	start, end := starts[n-1], s.lineEnd(starts, n)
+
+	return string(s.data[start:end]), nil
+}
+
+// Returns the rune offset at which the 1-based line n starts
+func (s *StringBuilder) LineStart(n int) (int, error) {
+	starts := s.lineStarts()
+	if err := checkLine("LineStart", n, len(starts)); err != nil {
+		return 0, err
+	}
+
+	return starts[n-1], nil
+}
+
+// Converts a rune offset, e.g. from FindFirst, into a 1-based line and column. The offset may point
+// right behind the last rune.
+func (s *StringBuilder) OffsetToLineCol(offset int) (line int, col int, err error) {
+	if err := checkIndex("OffsetToLineCol", offset, s.position, true); err != nil {
+		return 0, 0, err
+	}
+
+	starts := s.lineStarts()
+	line = sort.Search(len(starts), func(i int) bool { return starts[i] > offset })
+
+	return line, offset - starts[line-1] + 1, nil
+}
+
+// Converts a 1-based line and column into a rune offset. The column may point right behind the last rune
+// of the line, in front of its line ending.
+func (s *StringBuilder) LineColToOffset(line int, col int) (int, error) {
+	starts := s.lineStarts()
+	if err := checkLine("LineColToOffset", line, len(starts)); err != nil {
+		return 0, err
+	}
+
+	start, end := starts[line-1], s.lineEnd(starts, line)
+	if col < 1 || col > end-start+1 {
+		return 0, &RangeError{Op: "LineColToOffset", Index: col, Len: end - start, Err: ErrIndexOutOfRange}
+	}
+
+	return start + col - 1, nil
+}
+
+// Returns the start offsets of all lines, scanning the text which was added since the last call
+func (s *StringBuilder) lineStarts() []int {
+	if s.lines == nil {
+		s.lines = &lineIndex{starts: []int{0}}
+	}
+
+	ix := s.lines
+	text := s.runes()
+	for i := ix.scanned; i < len(text); i++ {
+		switch text[i] {
+		case '\n':
+			ix.starts = append(ix.starts, i+1)
+		case '\r':
+			if i+1 == len(text) {
+				// An appended '\n' would still belong to this line ending, so the '\r' is scanned again next time
+				ix.scanned = i
+				return append(ix.starts[:len(ix.starts):len(ix.starts)], i+1)
+			}
+			if text[i+1] != '\n' {
+				ix.starts = append(ix.starts, i+1)
+			}
+		}
+	}
+	ix.scanned = len(text)
+
+	return ix.starts
+}
+
+// Returns the offset behind the last rune of line n, in front of its line ending
+func (s *StringBuilder) lineEnd(starts []int, n int) int {
+	if n == len(starts) {
+		return s.position
+	}
+
+	end := starts[n] - 1
+	if end > starts[n-1] && s.data[end] == '\n' && s.data[end-1] == '\r' {
+		end--
+	}
+
+	return end
+}
+
+// Drops the part of the line index which an edit at start might have changed.
+// Whether start begins a line depends on the rune in front of it, so that rune is scanned again.
+func (s *StringBuilder) invalidateLines(start int) {
+	ix := s.lines
+	if ix == nil || start > ix.scanned {
+		return
+	}
+
+	keep := sort.SearchInts(ix.starts, start)
+	if keep == 0 {
+		keep = 1
+	}
+	ix.starts = ix.starts[:keep]
+	ix.scanned = start - 1
+	if ix.scanned < 0 {
+		ix.scanned = 0
+	}
+}
+
+// Checks that the 1-based line n exists
+func checkLine(op string, n int, count int) error {
+	if n < 1 || n > count {
+		return &RangeError{Op: op, Index: n, Len: count, Err: ErrIndexOutOfRange}
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+package Text
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestLines(t *testing.T) {
+	tests := []struct {
+		name  string
+		text  string
+		lines []string
+	}{
+		{"Empty", "", []string{""}},
+		{"Single line", "Hello", []string{"Hello"}},
+		{"LF", "a\nb\n", []string{"a", "b", ""}},
+		{"CRLF", "a\r\nb\r\nc", []string{"a", "b", "c"}},
+		{"Lone CR", "a\rb\r", []string{"a", "b", ""}},
+		{"Mixed", "a\r\n\rb\n\r\nc", []string{"a", "", "b", "", "c"}},
+		{"Empty lines", "\n\n", []string{"", "", ""}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewStringBuilderFromString(tt.text)
+
+			if s.LineCount() != len(tt.lines) {
+				t.Fatalf("LineCount() = %v, want %v", s.LineCount(), len(tt.lines))
+			}
+			for i, want := range tt.lines {
+				got, err := s.Line(i + 1)
+				if err != nil || got != want {
+					t.Errorf("Line(%v) = %q, %v, want %q", i+1, got, err, want)
+				}
+			}
+		})
+	}
+}
+
+func TestOffsetToLineCol(t *testing.T) {
+	s := NewStringBuilderFromString("func main() {\r\n\tpanic(\"ä\")\n}")
+	offset := s.FindFirst("panic")
+
+	line, col, err := s.OffsetToLineCol(offset)
+
+	if err != nil || line != 2 || col != 2 {
+		t.Errorf("OffsetToLineCol(%v) = %v:%v, %v, want 2:2", offset, line, col, err)
+	}
+	back, err := s.LineColToOffset(line, col)
+	if err != nil || back != offset {
+		t.Errorf("LineColToOffset(%v, %v) = %v, %v, want %v", line, col, back, err, offset)
+	}
+}
+
+func TestLineColToOffsetAllowsEndOfLine(t *testing.T) {
+	s := NewStringBuilderFromString("ab\r\ncd")
+
+	if offset, err := s.LineColToOffset(1, 3); err != nil || offset != 2 {
+		t.Errorf("LineColToOffset(1, 3) = %v, %v, want 2", offset, err)
+	}
+	if offset, err := s.LineColToOffset(2, 3); err != nil || offset != 6 {
+		t.Errorf("LineColToOffset(2, 3) = %v, %v, want 6", offset, err)
+	}
+}
+
+func TestLineQueriesOutOfRange(t *testing.T) {
+	s := NewStringBuilderFromString("a\nb")
+	var rangeErr *RangeError
+
+	if _, err := s.Line(0); !errors.As(err, &rangeErr) || rangeErr.Op != "Line" {
+		t.Errorf("Line(0) should fail with a *RangeError, got %v", err)
+	}
+	if _, err := s.LineStart(3); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("LineStart(3) should fail with ErrIndexOutOfRange, got %v", err)
+	}
+	if _, _, err := s.OffsetToLineCol(4); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("OffsetToLineCol(4) should fail with ErrIndexOutOfRange, got %v", err)
+	}
+	if _, err := s.LineColToOffset(1, 3); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("LineColToOffset(1, 3) should fail with ErrIndexOutOfRange, got %v", err)
+	}
+	if _, err := s.LineColToOffset(1, 0); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("LineColToOffset(1, 0) should fail with ErrIndexOutOfRange, got %v", err)
+	}
+}
+
+func TestLineIndexFollowsAppend(t *testing.T) {
+	s := NewStringBuilderFromString("a\r")
+	if s.LineCount() != 2 {
+		t.Fatalf("LineCount() = %v, want 2", s.LineCount())
+	}
+
+	s.Append("\nb")
+
+	if s.LineCount() != 2 {
+		t.Errorf("LineCount() = %v, want 2 as the appended \\n completes the \\r\\n", s.LineCount())
+	}
+	if start, _ := s.LineStart(2); start != 3 {
+		t.Errorf("LineStart(2) = %v, want 3", start)
+	}
+}
+
+func TestLineIndexFollowsEdits(t *testing.T) {
+	edits := []func(s *StringBuilder, r *rand.Rand){
+		func(s *StringBuilder, r *rand.Rand) { s.Append(randomLineText(r)) },
+		func(s *StringBuilder, r *rand.Rand) { s.Insert(r.Intn(s.Len()+1), randomLineText(r)) },
+		func(s *StringBuilder, r *rand.Rand) {
+			start := r.Intn(s.Len() + 1)
+			s.Remove(start, r.Intn(s.Len()-start+1))
+		},
+		func(s *StringBuilder, r *rand.Rand) { s.Replace("\r", "\n") },
+		func(s *StringBuilder, r *rand.Rand) { s.Reverse() },
+		func(s *StringBuilder, r *rand.Rand) { s.TrimEnd('\n') },
+	}
+	r := rand.New(rand.NewSource(20))
+	s := NewStringBuilder(16)
+
+	for i := 0; i < 500; i++ {
+		edits[r.Intn(len(edits))](s, r)
+
+		want := naiveLineStarts(s.ToString())
+		got := make([]int, s.LineCount())
+		for n := range got {
+			got[n], _ = s.LineStart(n + 1)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("Line starts of %q are %v, want %v", s.ToString(), got, want)
+		}
+	}
+}
+
+func TestConcurrentStringBuilderLines(t *testing.T) {
+	s := NewConcurrentStringBuilderFromString("a\nb")
+
+	if line, col, _ := s.OffsetToLineCol(2); line != 2 || col != 1 {
+		t.Errorf("OffsetToLineCol(2) = %v:%v, want 2:1", line, col)
+	}
+}
+
+func randomLineText(r *rand.Rand) string {
+	parts := []string{"a", "bc", "\n", "\r", "\r\n"}
+	text := ""
+	for n := r.Intn(4); n >= 0; n-- {
+		text += parts[r.Intn(len(parts))]
+	}
+
+	return text
+}
+
+func naiveLineStarts(text string) []int {
+	runes := []rune(text)
+	starts := []int{0}
+	for i, r := range runes {
+		if r == '\n' || r == '\r' && (i+1 == len(runes) || runes[i+1] != '\n') {
+			starts = append(starts, i+1)
+		}
+	}
+
+	return starts
+}
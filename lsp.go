@@ -0,0 +1,173 @@
+package Text
+
+import "unicode/utf8"
+
+// PositionEncoding tells in which units the character of a Position is counted
+type PositionEncoding int
+
+const (
+	// UTF-16 code units, the default of the Language Server Protocol. Runes outside of the BMP count twice.
+	UTF16 PositionEncoding = iota
+	// UTF-8 bytes
+	UTF8
+	// Runes, which is what all other methods of the StringBuilder use
+	UTF32
+)
+
+// Position is a 0-based line and character like in the Language Server Protocol
+type Position struct {
+	Line      int
+	Character int
+}
+
+// Range spans from Start (inclusive) to End (exclusive)
+type Range struct {
+	Start Position
+	End   Position
+}
+
+// Converts a rune offset into an offset in UTF-16 code units
+func (s *StringBuilder) RuneToUTF16(offset int) (int, error) {
+	if err := checkIndex("RuneToUTF16", offset, s.position, true); err != nil {
+		return 0, err
+	}
+
+	return unitCount(s.data[:offset], UTF16), nil
+}
+
+// Converts an offset in UTF-16 code units into a rune offset.
+// Returns a *RangeError with ErrSplitRune if the offset points between the two halves of a surrogate pair.
+func (s *StringBuilder) UTF16ToRune(offset int) (int, error) {
+	return s.unitsToRune("UTF16ToRune", offset, UTF16)
+}
+
+// Converts an offset in UTF-8 bytes into a rune offset.
+// Returns a *RangeError with ErrSplitRune if the offset points into the encoding of a rune.
+func (s *StringBuilder) ByteToRune(offset int) (int, error) {
+	return s.unitsToRune("ByteToRune", offset, UTF8)
+}
+
+// Converts a rune offset into an offset in UTF-8 bytes
+func (s *StringBuilder) RuneToByte(offset int) (int, error) {
+	if err := checkIndex("RuneToByte", offset, s.position, true); err != nil {
+		return 0, err
+	}
+
+	return unitCount(s.data[:offset], UTF8), nil
+}
+
+// Replaces the text in r with newText like a TextEdit of the Language Server Protocol.
+// The characters of r are counted in the given encoding. A character behind the end of its line
+// means the end of the line. The edit is one step of the history.
+func (s *StringBuilder) ApplyTextEdit(r Range, newText string, encoding PositionEncoding) error {
+	start, err := s.positionToOffset("ApplyTextEdit", r.Start, encoding)
+	if err != nil {
+		return err
+	}
+	end, err := s.positionToOffset("ApplyTextEdit", r.End, encoding)
+	if err != nil {
+		return err
+	}
+	if start > end {
+		return &RangeError{Op: "ApplyTextEdit", Index: start, Length: end - start, Len: s.position, Err: ErrInvalidRange}
+	}
+
+	// Makes sure the Insert can't fail after the Remove
+	if added := utf8.RuneCountInString(newText) - (end - start); added > 0 {
+		if err := s.reserve(added); err != nil {
+			return err
+		}
+	}
+
+	s.beginEdit()
+	defer s.endEdit()
+
+	if err := s.Remove(start, end-start); err != nil {
+		return err
+	}
+
+	return s.Insert(start, newText)
+}
+
+// Converts a Position into a rune offset
+func (s *StringBuilder) positionToOffset(op string, p Position, encoding PositionEncoding) (int, error) {
+	starts := s.lineStarts()
+	if err := checkIndex(op, p.Line, len(starts), false); err != nil {
+		return 0, err
+	}
+	if p.Character < 0 {
+		return 0, &RangeError{Op: op, Index: p.Character, Len: s.position, Err: ErrNegativeIndex}
+	}
+
+	start, end := starts[p.Line], s.lineEnd(starts, p.Line+1)
+	units := 0
+	for i := start; i < end; i++ {
+		if units >= p.Character {
+			if units > p.Character {
+				return 0, &RangeError{Op: op, Index: p.Character, Len: s.position, Err: ErrSplitRune}
+			}
+			return i, nil
+		}
+		units += unitLen(s.data[i], encoding)
+	}
+	if units > p.Character {
+		return 0, &RangeError{Op: op, Index: p.Character, Len: s.position, Err: ErrSplitRune}
+	}
+
+	return end, nil
+}
+
+// Converts an offset in the given units into a rune offset
+func (s *StringBuilder) unitsToRune(op string, offset int, encoding PositionEncoding) (int, error) {
+	if offset < 0 {
+		return 0, &RangeError{Op: op, Index: offset, Len: s.position, Err: ErrNegativeIndex}
+	}
+
+	units := 0
+	for i, r := range s.data[:s.position] {
+		if units == offset {
+			return i, nil
+		}
+		units += unitLen(r, encoding)
+		if units > offset {
+			return 0, &RangeError{Op: op, Index: offset, Len: s.position, Err: ErrSplitRune}
+		}
+	}
+	if units == offset {
+		return s.position, nil
+	}
+
+	return 0, &RangeError{Op: op, Index: offset, Len: units, Err: ErrIndexOutOfRange}
+}
+
+func unitCount(runes []rune, encoding PositionEncoding) int {
+	if encoding == UTF32 {
+		return len(runes)
+	}
+
+	count := 0
+	for _, r := range runes {
+		count += unitLen(r, encoding)
+	}
+
+	return count
+}
+
+// Returns the number of units the rune takes in the encoding. Invalid runes are
+// written as utf8.RuneError, so they count like it.
+func unitLen(r rune, encoding PositionEncoding) int {
+	switch encoding {
+	case UTF16:
+		if r >= 0x10000 && r <= utf8.MaxRune {
+			return 2
+		}
+		return 1
+	case UTF8:
+		if n := utf8.RuneLen(r); n > 0 {
+			return n
+		}
+		return utf8.RuneLen(utf8.RuneError)
+	default:
+		return 1
+	}
+}
@@ -0,0 +1,141 @@
+package Text
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestOffsetConversions(t *testing.T) {
+	// 'ä' takes two bytes, '😀' four bytes and two UTF-16 code units
+	s := NewStringBuilderFromString("aä😀b")
+	tests := []struct {
+		runes, bytes, utf16 int
+	}{
+		{0, 0, 0},
+		{1, 1, 1},
+		{2, 3, 2},
+		{3, 7, 4},
+		{4, 8, 5},
+	}
+	for _, tt := range tests {
+		if got, err := s.RuneToUTF16(tt.runes); err != nil || got != tt.utf16 {
+			t.Errorf("RuneToUTF16(%v) = %v, %v, want %v", tt.runes, got, err, tt.utf16)
+		}
+		if got, err := s.UTF16ToRune(tt.utf16); err != nil || got != tt.runes {
+			t.Errorf("UTF16ToRune(%v) = %v, %v, want %v", tt.utf16, got, err, tt.runes)
+		}
+		if got, err := s.RuneToByte(tt.runes); err != nil || got != tt.bytes {
+			t.Errorf("RuneToByte(%v) = %v, %v, want %v", tt.runes, got, err, tt.bytes)
+		}
+		if got, err := s.ByteToRune(tt.bytes); err != nil || got != tt.runes {
+			t.Errorf("ByteToRune(%v) = %v, %v, want %v", tt.bytes, got, err, tt.runes)
+		}
+	}
+}
+
+func TestOffsetConversionsFail(t *testing.T) {
+	s := NewStringBuilderFromString("aä😀b")
+
+	if _, err := s.UTF16ToRune(3); !errors.Is(err, ErrSplitRune) {
+		t.Errorf("UTF16ToRune(3) should fail with ErrSplitRune, got %v", err)
+	}
+	if _, err := s.ByteToRune(2); !errors.Is(err, ErrSplitRune) {
+		t.Errorf("ByteToRune(2) should fail with ErrSplitRune, got %v", err)
+	}
+	if _, err := s.ByteToRune(9); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("ByteToRune(9) should fail with ErrIndexOutOfRange, got %v", err)
+	}
+	if _, err := s.UTF16ToRune(-1); !errors.Is(err, ErrNegativeIndex) {
+		t.Errorf("UTF16ToRune(-1) should fail with ErrNegativeIndex, got %v", err)
+	}
+	if _, err := s.RuneToUTF16(5); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("RuneToUTF16(5) should fail with ErrIndexOutOfRange, got %v", err)
+	}
+}
+
+func TestApplyTextEdit(t *testing.T) {
+	tests := []struct {
+		name     string
+		r        Range
+		newText  string
+		encoding PositionEncoding
+		want     string
+	}{
+		{"Insert", Range{Position{1, 0}, Position{1, 0}}, "// ", UTF16, "let x = \"😀\";\r\n// x.len()\n"},
+		{"Replace behind emoji in UTF-16", Range{Position{0, 12}, Position{0, 13}}, "", UTF16, "let x = \"😀\"\r\nx.len()\n"},
+		{"Replace behind emoji in UTF-8", Range{Position{0, 14}, Position{0, 15}}, "", UTF8, "let x = \"😀\"\r\nx.len()\n"},
+		{"Replace behind emoji in runes", Range{Position{0, 11}, Position{0, 12}}, "", UTF32, "let x = \"😀\"\r\nx.len()\n"},
+		{"Replace emoji", Range{Position{0, 9}, Position{0, 11}}, "🎉", UTF16, "let x = \"🎉\";\r\nx.len()\n"},
+		{"Across lines", Range{Position{0, 12}, Position{1, 1}}, "; y", UTF16, "let x = \"😀\"; y.len()\n"},
+		{"Character behind the line end", Range{Position{0, 100}, Position{1, 0}}, "", UTF16, "let x = \"😀\";x.len()\n"},
+		{"Last line", Range{Position{2, 0}, Position{2, 0}}, "end", UTF16, "let x = \"😀\";\r\nx.len()\nend"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewStringBuilderFromString("let x = \"😀\";\r\nx.len()\n")
+
+			if err := s.ApplyTextEdit(tt.r, tt.newText, tt.encoding); err != nil {
+				t.Fatalf("ApplyTextEdit() threw an error: %v", err)
+			}
+
+			if s.ToString() != tt.want {
+				t.Errorf("Got %q, want %q", s.ToString(), tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyTextEditFails(t *testing.T) {
+	tests := []struct {
+		name string
+		r    Range
+		want error
+	}{
+		{"Inside of a surrogate pair", Range{Position{0, 10}, Position{0, 10}}, ErrSplitRune},
+		{"Unknown line", Range{Position{3, 0}, Position{3, 0}}, ErrIndexOutOfRange},
+		{"Negative character", Range{Position{0, -1}, Position{0, 0}}, ErrNegativeIndex},
+		{"End in front of start", Range{Position{1, 0}, Position{0, 0}}, ErrInvalidRange},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewStringBuilderFromString("let x = \"😀\";\r\nx.len()\n")
+
+			err := s.ApplyTextEdit(tt.r, "", UTF16)
+
+			if !errors.Is(err, tt.want) {
+				t.Errorf("ApplyTextEdit() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyTextEditIsOneUndoStep(t *testing.T) {
+	s := NewStringBuilderFromString("Hello World", WithHistory(10))
+
+	s.ApplyTextEdit(Range{Position{0, 6}, Position{0, 11}}, "Gopher", UTF16)
+	s.Undo()
+
+	if s.ToString() != "Hello World" {
+		t.Errorf("Got %q after Undo, want %q", s.ToString(), "Hello World")
+	}
+}
+
+func TestApplyTextEditRespectsMaxCapacity(t *testing.T) {
+	s := NewStringBuilderFromString("Hello", WithMaxCapacity(8))
+
+	err := s.ApplyTextEdit(Range{Position{0, 0}, Position{0, 1}}, "Hello J", UTF16)
+
+	if !errors.Is(err, ErrCapacityExceeded) || s.ToString() != "Hello" {
+		t.Errorf("ApplyTextEdit() = %v with content %q, want ErrCapacityExceeded and an unchanged content", err, s.ToString())
+	}
+}
+
+func TestConcurrentStringBuilderApplyTextEdit(t *testing.T) {
+	s := NewConcurrentStringBuilderFromString("a😀")
+
+	s.ApplyTextEdit(Range{Position{0, 3}, Position{0, 3}}, "b", UTF16)
+
+	if s.ToString() != "a😀b" {
+		t.Errorf("Got %q, want %q", s.ToString(), "a😀b")
+	}
+}
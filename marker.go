@@ -0,0 +1,138 @@
+package Text
+
+import "sync"
+
+// Gravity decides where a Marker goes when text is inserted exactly at its position
+type Gravity int
+
+const (
+	// The marker stays in front of text inserted at its position
+	LeftGravity Gravity = iota
+	// The marker moves behind text inserted at its position
+	RightGravity
+)
+
+// Marker is a position in a StringBuilder which moves along when text in front of it is inserted or removed.
+// Positions are the gaps between runes, from 0 in front of the first rune up to Len() behind the last one.
+type Marker struct {
+	builder     *StringBuilder
+	position    int
+	gravity     Gravity
+	invalidated bool
+	// Set for markers of a ConcurrentStringBuilder
+	mutex *sync.RWMutex
+}
+
+// Places a marker at the given position, which can be anything from 0 to Len(). The marker has LeftGravity.
+// Release it once it isn't needed anymore, as every marker is updated on every edit.
+func (s *StringBuilder) Mark(position int) (*Marker, error) {
+	if err := checkIndex("Mark", position, s.position, true); err != nil {
+		return nil, err
+	}
+
+	m := &Marker{builder: s, position: position}
+	s.markers = append(s.markers, m)
+
+	return m, nil
+}
+
+// Returns the current position of the marker
+func (m *Marker) Position() int {
+	m.rlock()
+	defer m.runlock()
+
+	return m.position
+}
+
+// Reports whether the text around the marker was removed. The marker then sits at the start of the removed range.
+func (m *Marker) Invalidated() bool {
+	m.rlock()
+	defer m.runlock()
+
+	return m.invalidated
+}
+
+// Returns the gravity of the marker
+func (m *Marker) Gravity() Gravity {
+	m.rlock()
+	defer m.runlock()
+
+	return m.gravity
+}
+
+// Sets where the marker goes when text is inserted exactly at its position
+func (m *Marker) SetGravity(gravity Gravity) *Marker {
+	m.lock()
+	defer m.unlock()
+
+	m.gravity = gravity
+
+	return m
+}
+
+// Stops updating the marker. Its position stays where it is.
+func (m *Marker) Release() {
+	m.lock()
+	defer m.unlock()
+
+	if m.builder == nil {
+		return
+	}
+
+	markers := m.builder.markers
+	for i, other := range markers {
+		if other == m {
+			copy(markers[i:], markers[i+1:])
+			markers[len(markers)-1] = nil
+			m.builder.markers = markers[:len(markers)-1]
+			break
+		}
+	}
+	m.builder = nil
+}
+
+// Moves the markers for an edit which replaced removed runes at start with inserted runes, or reversed the text.
+// Gravity only matters for pure insertions. Markers in front of or behind replaced text stay there.
+func (s *StringBuilder) moveMarkers(start, removed, inserted int, reversed bool) {
+	for _, m := range s.markers {
+		switch {
+		case reversed:
+			m.position = s.position - m.position
+		case m.position < start:
+		case m.position == start && removed == 0:
+			if m.gravity == RightGravity {
+				m.position += inserted
+			}
+		case m.position == start:
+		case m.position < start+removed:
+			m.position = start
+			m.invalidated = true
+		default:
+			m.position += inserted - removed
+		}
+	}
+}
+
+func (m *Marker) lock() {
+	if m.mutex != nil {
+		m.mutex.Lock()
+	}
+}
+
+func (m *Marker) unlock() {
+	if m.mutex != nil {
+		m.mutex.Unlock()
+	}
+}
+
+func (m *Marker) rlock() {
+	if m.mutex != nil {
+		m.mutex.RLock()
+	}
+}
+
+func (m *Marker) runlock() {
+	if m.mutex != nil {
+		m.mutex.RUnlock()
+	}
+}
@@ -0,0 +1,109 @@
+package Text
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMarkerFollowsEdits(t *testing.T) {
+	tests := []struct {
+		name            string
+		edit            func(s *StringBuilder)
+		wantPosition    int
+		wantInvalidated bool
+	}{
+		{"Insert in front", func(s *StringBuilder) { s.Insert(0, "import fmt\n") }, 17, false},
+		{"Insert behind", func(s *StringBuilder) { s.Insert(8, "!!!") }, 6, false},
+		{"Append", func(s *StringBuilder) { s.Append(" and more") }, 6, false},
+		{"Remove in front", func(s *StringBuilder) { s.Remove(0, 2) }, 4, false},
+		{"Remove around", func(s *StringBuilder) { s.Remove(4, 4) }, 4, true},
+		{"Remove ending at marker", func(s *StringBuilder) { s.Remove(2, 4) }, 2, false},
+		{"Remove starting at marker", func(s *StringBuilder) { s.Remove(6, 2) }, 6, false},
+		{"Replace in front", func(s *StringBuilder) { s.Replace("  ", " ") }, 5, false},
+		{"Replace around", func(s *StringBuilder) { s.Replace("Hello World", "Hi") }, 2, true},
+		{"TrimStart", func(s *StringBuilder) { s.TrimStart() }, 4, false},
+		{"Reverse", func(s *StringBuilder) { s.Reverse() }, 9, false},
+		{"SetRuneAt", func(s *StringBuilder) { s.SetRuneAt(6, 'w') }, 6, false},
+		{"Clear", func(s *StringBuilder) { s.Clear() }, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewStringBuilderFromString("  Hello World  ")
+			m, err := s.Mark(6)
+			if err != nil {
+				t.Fatalf("StringBuilder.Mark() threw an error: %v", err)
+			}
+
+			tt.edit(s)
+
+			if m.Position() != tt.wantPosition || m.Invalidated() != tt.wantInvalidated {
+				t.Errorf("Marker at %v, invalidated %v, want %v, %v", m.Position(), m.Invalidated(), tt.wantPosition, tt.wantInvalidated)
+			}
+		})
+	}
+}
+
+func TestMarkerGravity(t *testing.T) {
+	s := NewStringBuilderFromString("Hello World")
+	left, _ := s.Mark(5)
+	right, _ := s.Mark(5)
+	right.SetGravity(RightGravity)
+	end, _ := s.Mark(11)
+	end.SetGravity(RightGravity)
+
+	s.Insert(5, ",")
+	s.Append("!")
+
+	if left.Position() != 5 {
+		t.Errorf("Marker with LeftGravity at %v, want %v", left.Position(), 5)
+	}
+	if right.Position() != 6 {
+		t.Errorf("Marker with RightGravity at %v, want %v", right.Position(), 6)
+	}
+	if end.Position() != 13 {
+		t.Errorf("Marker with RightGravity at the end at %v, want %v", end.Position(), 13)
+	}
+}
+
+func TestMarkerFollowsUndoAndRollback(t *testing.T) {
+	s := NewStringBuilderFromString("package main\n\nfunc main() {}", WithHistory(10))
+	body, _ := s.Mark(14)
+
+	tx := s.Begin()
+	s.Insert(13, "import \"fmt\"\n")
+	if body.Position() != 27 {
+		t.Errorf("Marker at %v, want %v", body.Position(), 27)
+	}
+	tx.Rollback()
+	if body.Position() != 14 {
+		t.Errorf("Marker after Rollback at %v, want %v", body.Position(), 14)
+	}
+
+	s.Undo()
+	if body.Position() != 27 {
+		t.Errorf("Marker after undoing the Rollback at %v, want %v", body.Position(), 27)
+	}
+}
+
+func TestReleasedMarkerStopsMoving(t *testing.T) {
+	s := NewStringBuilderFromString("Hello World")
+	m, _ := s.Mark(6)
+
+	m.Release()
+	s.Insert(0, ">> ")
+
+	if m.Position() != 6 || len(s.markers) != 0 {
+		t.Errorf("Released marker at %v, want %v", m.Position(), 6)
+	}
+}
+
+func TestMarkOutOfRange(t *testing.T) {
+	s := NewStringBuilderFromString("Hello")
+
+	if _, err := s.Mark(6); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("StringBuilder.Mark() = %v, want %v", err, ErrIndexOutOfRange)
+	}
+	if _, err := s.Mark(5); err != nil {
+		t.Errorf("StringBuilder.Mark() at the end threw an error: %v", err)
+	}
+}
@@ -0,0 +1,236 @@
+package Text
+
+import "sort"
+
+// NormalizationForm is one of the Unicode normalization forms of UAX #15
+type NormalizationForm int
+
+const (
+	// Canonical composition, "é" becomes U+00E9
+	NFC NormalizationForm = iota
+	// Canonical decomposition, "é" becomes 'e' followed by U+0301
+	NFD
+	// Compatibility composition, additionally "ﬁ" becomes "fi" and "²" becomes "2"
+	NFKC
+	// Compatibility decomposition
+	NFKD
+)
+
+func (f NormalizationForm) String() string {
+	switch f {
+	case NFC:
+		return "NFC"
+	case NFD:
+		return "NFD"
+	case NFKC:
+		return "NFKC"
+	case NFKD:
+		return "NFKD"
+	}
+
+	return "Unknown"
+}
+
+type combiningClassRange struct {
+	lo, hi rune
+	class  uint8
+}
+
+// Hangul syllables are composed and decomposed algorithmically
+const (
+	hangulSBase  = 0xAC00
+	hangulLBase  = 0x1100
+	hangulVBase  = 0x1161
+	hangulTBase  = 0x11A7
+	hangulLCount = 19
+	hangulVCount = 21
+	hangulTCount = 28
+	hangulNCount = hangulVCount * hangulTCount
+	hangulSCount = hangulLCount * hangulNCount
+)
+
+// Brings the text into the given normalization form, so canonically equivalent texts consist of the same runes.
+// Only the part which changes is replaced.
+func (s *StringBuilder) Normalize(form NormalizationForm) *StringBuilder {
+	text := s.runes()
+	normalized := normalizeRunes(text, form)
+
+	start := 0
+	for start < len(text) && start < len(normalized) && text[start] == normalized[start] {
+		start++
+	}
+	if start == len(text) && start == len(normalized) {
+		return s
+	}
+	end, newEnd := len(text), len(normalized)
+	for end > start && newEnd > start && text[end-1] == normalized[newEnd-1] {
+		end--
+		newEnd--
+	}
+
+	if err := s.replaceRanges([]replacement{{start, end, normalized[start:newEnd]}}, ChangeNormalize); err != nil {
+		s.setErr(err)
+	}
+
+	return s
+}
+
+// Reports whether the text is in the given normalization form
+func (s *StringBuilder) IsNormalized(form NormalizationForm) bool {
+	text := s.runes()
+	normalized := normalizeRunes(text, form)
+	if len(text) != len(normalized) {
+		return false
+	}
+	for i := range text {
+		if text[i] != normalized[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func normalizeRunes(text []rune, form NormalizationForm) []rune {
+	decomposed := decompose(text, form == NFKC || form == NFKD, nil)
+	if form == NFC || form == NFKC {
+		return compose(decomposed)
+	}
+
+	return decomposed
+}
+
+// Decomposes the text and brings the combining marks into canonical order.
+// If origins isn't nil, it is replaced by the origin of every decomposed rune, see appendOrigins.
+func decompose(text []rune, compatibility bool, origins *[]int) []rune {
+	result := make([]rune, 0, len(text))
+	var decomposedOrigins []int
+	for i, r := range text {
+		before := len(result)
+		result = appendDecomposition(result, r, compatibility)
+		decomposedOrigins = appendOrigins(decomposedOrigins, origins, i, len(result)-before)
+	}
+	if origins != nil {
+		*origins = decomposedOrigins
+	}
+
+	// Runs of non-starters are sorted by their combining class, keeping the order of equal classes
+	for i := 0; i < len(result); {
+		if combiningClass(result[i]) == 0 {
+			i++
+			continue
+		}
+		j := i
+		for j < len(result) && combiningClass(result[j]) != 0 {
+			j++
+		}
+		if j-i > 1 {
+			sort.Stable(combiningRun{result[i:j], originsOf(origins, i, j)})
+		}
+		i = j
+	}
+
+	return result
+}
+
+func appendDecomposition(result []rune, r rune, compatibility bool) []rune {
+	if r >= hangulSBase && r < hangulSBase+hangulSCount {
+		index := r - hangulSBase
+		result = append(result, hangulLBase+index/hangulNCount, hangulVBase+index%hangulNCount/hangulTCount)
+		if t := index % hangulTCount; t != 0 {
+			result = append(result, hangulTBase+t)
+		}
+		return result
+	}
+	if compatibility {
+		if d, ok := compatibilityDecompositions[r]; ok {
+			return append(result, []rune(d)...)
+		}
+	}
+	if d, ok := canonicalDecompositions[r]; ok {
+		return append(result, []rune(d)...)
+	}
+
+	return append(result, r)
+}
+
+// Combines starters with the following runes into primary composites, following the canonical composition algorithm
+func compose(text []rune) []rune {
+	result := text[:0]
+	starter := -1
+	lastClass := 0
+	for _, r := range text {
+		class := int(combiningClass(r))
+		if starter >= 0 {
+			// A rune between the starter and r blocks the composition if its class isn't lower than the one of r
+			blocked := len(result)-1 != starter && (lastClass == 0 || lastClass >= class)
+			if !blocked {
+				if composite, ok := composePair(result[starter], r); ok {
+					result[starter] = composite
+					continue
+				}
+			}
+		}
+		if class == 0 {
+			starter = len(result)
+		}
+		lastClass = class
+		result = append(result, r)
+	}
+
+	return result
+}
+
+func composePair(a, b rune) (rune, bool) {
+	if a >= hangulLBase && a < hangulLBase+hangulLCount && b >= hangulVBase && b < hangulVBase+hangulVCount {
+		return hangulSBase + ((a-hangulLBase)*hangulVCount+b-hangulVBase)*hangulTCount, true
+	}
+	if a >= hangulSBase && a < hangulSBase+hangulSCount && (a-hangulSBase)%hangulTCount == 0 &&
+		b > hangulTBase && b < hangulTBase+hangulTCount {
+		return a + b - hangulTBase, true
+	}
+
+	composite, ok := canonicalCompositions[[2]rune{a, b}]
+
+	return composite, ok
+}
+
+func combiningClass(r rune) uint8 {
+	if r < 0x0300 {
+		return 0
+	}
+
+	i := sort.Search(len(combiningClassTable), func(i int) bool { return combiningClassTable[i].hi >= r })
+	if i < len(combiningClassTable) && combiningClassTable[i].lo <= r {
+		return combiningClassTable[i].class
+	}
+
+	return 0
+}
+
+func originsOf(origins *[]int, i, j int) []int {
+	if origins == nil {
+		return nil
+	}
+
+	return (*origins)[i:j]
+}
+
+// Sorts a run of non-starters by combining class and moves their origins along
+type combiningRun struct {
+	runes   []rune
+	origins []int
+}
+
+func (c combiningRun) Len() int { return len(c.runes) }
+
+func (c combiningRun) Less(i, j int) bool {
+	return combiningClass(c.runes[i]) < combiningClass(c.runes[j])
+}
+
+func (c combiningRun) Swap(i, j int) {
+	c.runes[i], c.runes[j] = c.runes[j], c.runes[i]
+	if c.origins != nil {
+		c.origins[i], c.origins[j] = c.origins[j], c.origins[i]
+	}
+}
@@ -0,0 +1,151 @@
+package Text
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name                 string
+		text                 string
+		nfc, nfd, nfkc, nfkd string
+	}{
+		{"ASCII", "abc", "abc", "abc", "abc", "abc"},
+		{"Precomposed", "\u00E9", "\u00E9", "e\u0301", "\u00E9", "e\u0301"},
+		{"Decomposed", "e\u0301", "\u00E9", "e\u0301", "\u00E9", "e\u0301"},
+		{"Singleton", "\u212B", "\u00C5", "A\u030A", "\u00C5", "A\u030A"},
+		{"Canonical order", "q\u0307\u0323", "q\u0323\u0307", "q\u0323\u0307", "q\u0323\u0307", "q\u0323\u0307"},
+		{"Two marks", "\u1E0B\u0323", "\u1E0D\u0307", "d\u0323\u0307", "\u1E0D\u0307", "d\u0323\u0307"},
+		{"Compatibility", "\uFB01", "\uFB01", "\uFB01", "fi", "fi"},
+		{"Compatibility with marks", "\u1E9B\u0323", "\u1E9B\u0323", "\u017F\u0323\u0307", "\u1E69", "s\u0323\u0307"},
+		{"Hangul", "\uAC01", "\uAC01", "\u1100\u1161\u11A8", "\uAC01", "\u1100\u1161\u11A8"},
+		{"Hangul jamo", "\u1100\u1161\u11A8", "\uAC01", "\u1100\u1161\u11A8", "\uAC01", "\u1100\u1161\u11A8"},
+		{"Excluded composition", "\u0958", "\u0915\u093C", "\u0915\u093C", "\u0915\u093C", "\u0915\u093C"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for form, want := range map[NormalizationForm]string{NFC: tt.nfc, NFD: tt.nfd, NFKC: tt.nfkc, NFKD: tt.nfkd} {
+				s := NewStringBuilderFromString(tt.text)
+
+				s.Normalize(form)
+
+				if s.ToString() != want {
+					t.Errorf("Form %v: got %+q, want %+q", form, s.ToString(), want)
+				}
+				if !s.IsNormalized(form) {
+					t.Errorf("Form %v: IsNormalized() is false after Normalize", form)
+				}
+			}
+		})
+	}
+}
+
+func TestNormalizeOnlyReplacesTheChangedPart(t *testing.T) {
+	s := NewStringBuilderFromString("Cafe\u0301 ole\u0301")
+	var events []ChangeEvent
+	s.OnChange(func(ev ChangeEvent) { events = append(events, ev) })
+
+	s.Normalize(NFC)
+
+	want := []ChangeEvent{{ChangeNormalize, 3, 10, "e\u0301 ole\u0301", "\u00E9 ol\u00E9"}}
+	if !reflect.DeepEqual(events, want) {
+		t.Errorf("Got events %+q, want %+q", events, want)
+	}
+}
+
+func TestNormalizeWithoutChange(t *testing.T) {
+	s := NewStringBuilderFromString("Caf\u00E9", WithHistory(10))
+
+	s.Normalize(NFC)
+
+	if s.CanUndo() {
+		t.Error("Normalizing a normalized text shouldn't record an edit")
+	}
+}
+
+func TestFindWithCanonicalEquivalence(t *testing.T) {
+	s := NewStringBuilderFromString("cafe\u0301 caf\u00E9 cafe")
+
+	if got := s.FindFirst("caf\u00E9", CanonicalEquivalence()); got != 0 {
+		t.Errorf("FindFirst() = %v, want 0", got)
+	}
+	if got := s.FindLast("cafe\u0301", CanonicalEquivalence()); got != 6 {
+		t.Errorf("FindLast() = %v, want 6", got)
+	}
+	if got := s.FindAll("caf\u00E9", CanonicalEquivalence()); !reflect.DeepEqual(got, []int{0, 6}) {
+		t.Errorf("FindAll() = %v, want [0 6]", got)
+	}
+	if got := s.FindAll("cafe", CanonicalEquivalence()); !reflect.DeepEqual(got, []int{11}) {
+		t.Errorf("FindAll(\"cafe\") = %v, want [11] as the accent belongs to the other occurrences", got)
+	}
+	if got := s.FindFirst("caf\u00E9"); got != 6 {
+		t.Errorf("FindFirst() without the option = %v, want 6", got)
+	}
+}
+
+func TestFindWithCanonicalEquivalenceDoesNotSplitRunes(t *testing.T) {
+	tests := []struct {
+		name   string
+		text   string
+		needle string
+		want   int
+	}{
+		{"Base of a precomposed rune", "\u00E9", "e", -1},
+		{"Mark of a precomposed rune", "\u00E9", "\u0301", -1},
+		{"Hangul syllable", "\uAC01", "\uAC00", -1},
+		{"Reordered marks", "\u00E0\u0323", "a\u0323", -1},
+		{"Different mark order", "a\u0323\u0300", "\u00E0\u0323", 0},
+		{"Standalone mark", "e\u0301", "\u0301", 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewStringBuilderFromString(tt.text)
+
+			if got := s.FindFirst(tt.needle, CanonicalEquivalence()); got != tt.want {
+				t.Errorf("FindFirst(%+q) = %v, want %v", tt.needle, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReplaceWithCanonicalEquivalence(t *testing.T) {
+	s := NewStringBuilderFromString("Cafe\u0301 and Caf\u00E9 and Cafe")
+
+	s.Replace("Caf\u00E9", "Tea", CanonicalEquivalence())
+
+	if want := "Tea and Tea and Cafe"; s.ToString() != want {
+		t.Errorf("Got %+q, want %+q", s.ToString(), want)
+	}
+}
+
+func TestReplaceFirstAndLastWithCanonicalEquivalence(t *testing.T) {
+	s := NewStringBuilderFromString("e\u0301 \u00E9 e\u0301")
+
+	s.ReplaceFirst("\u00E9", "1", CanonicalEquivalence()).ReplaceLast("\u00E9", "3", CanonicalEquivalence())
+
+	if want := "1 \u00E9 3"; s.ToString() != want {
+		t.Errorf("Got %+q, want %+q", s.ToString(), want)
+	}
+}
+
+func TestSnapshotFindWithCanonicalEquivalence(t *testing.T) {
+	s := NewStringBuilderFromString("Resume\u0301.pdf").Snapshot()
+
+	if got := s.FindFirst("R\u00E9sum\u00E9", CanonicalEquivalence()); got != -1 {
+		t.Errorf("FindFirst() = %v, want -1", got)
+	}
+	if got := s.FindFirst("Resum\u00E9.pdf", CanonicalEquivalence()); got != 0 {
+		t.Errorf("FindFirst() = %v, want 0", got)
+	}
+}
+
+func TestConcurrentStringBuilderNormalize(t *testing.T) {
+	s := NewConcurrentStringBuilderFromString("e\u0301")
+
+	s.Normalize(NFC)
+
+	if s.ToString() != "\u00E9" || !s.IsNormalized(NFC) {
+		t.Errorf("Got %+q, want %+q", s.ToString(), "\u00E9")
+	}
+}
@@ -0,0 +1,394 @@
+package Text
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// A decimal number 0.d1d2d3... * 10^exp. The digits are stored as ASCII without leading zeros.
+// Zero has no digits at all.
+type decimalNumber struct {
+	negative bool
+	digits   []byte
+	exp      int
+}
+
+func decimalFromUint(buffer []byte, value uint64, negative bool) decimalNumber {
+	if value == 0 {
+		return decimalNumber{}
+	}
+
+	digits := strconv.AppendUint(buffer[:0], value, 10)
+	return decimalNumber{negative: negative, digits: digits, exp: len(digits)}
+}
+
+func decimalFromInt(buffer []byte, value int64) decimalNumber {
+	if value < 0 {
+		return decimalFromUint(buffer, uint64(-(value+1))+1, true)
+	}
+
+	return decimalFromUint(buffer, uint64(value), false)
+}
+
+// Uses the shortest digits which still round-trip to value
+func decimalFromFloat(buffer []byte, value float64, bitSize int) decimalNumber {
+	negative := value < 0
+	if negative {
+		value = -value
+	}
+	if value == 0 {
+		return decimalNumber{}
+	}
+
+	// Produces d.ddddde±xx
+	formatted := strconv.AppendFloat(buffer[:0], value, 'e', -1, bitSize)
+	e := len(formatted) - 1
+	for formatted[e] != 'e' {
+		e--
+	}
+	exp := 0
+	for _, c := range formatted[e+2:] {
+		exp = exp*10 + int(c-'0')
+	}
+	if formatted[e+1] == '-' {
+		exp = -exp
+	}
+
+	digits := formatted[:1]
+	if e > 1 {
+		digits = append(digits, formatted[2:e]...)
+	}
+
+	return decimalNumber{negative: negative, digits: digits, exp: exp + 1}
+}
+
+func (d decimalNumber) isZero() bool {
+	return len(d.digits) == 0
+}
+
+// Returns the number rounded half away from zero to its first n digits
+func (d decimalNumber) rounded(n int) decimalNumber {
+	if n >= len(d.digits) {
+		return d
+	}
+	if n < 0 {
+		return decimalNumber{digits: d.digits[:0]}
+	}
+
+	roundUp := d.digits[n] >= '5'
+	digits := d.digits[:n]
+	if roundUp {
+		i := n - 1
+		for i >= 0 && digits[i] == '9' {
+			i--
+		}
+		if i < 0 {
+			digits = append(digits[:0], '1')
+			d.exp++
+		} else {
+			digits[i]++
+			digits = digits[:i+1]
+		}
+	}
+
+	for len(digits) > 0 && digits[len(digits)-1] == '0' {
+		digits = digits[:len(digits)-1]
+	}
+	if len(digits) == 0 {
+		return decimalNumber{digits: digits}
+	}
+
+	return decimalNumber{negative: d.negative, digits: digits, exp: d.exp}
+}
+
+// Returns the digit at position i counted from the first digit, or '0' outside of the stored digits
+func (d decimalNumber) digit(i int) byte {
+	if i < 0 || i >= len(d.digits) {
+		return '0'
+	}
+
+	return d.digits[i]
+}
+
+// Appends the absolute value in fixed-point notation with the given number of decimals.
+// Integer digits get separated into groups if group is set.
+func (d decimalNumber) appendFixed(dst []byte, decimals int, group bool, c *Culture) []byte {
+	if d.exp <= 0 {
+		dst = append(dst, '0')
+	}
+	for i := 0; i < d.exp; i++ {
+		if group && i > 0 && c.isGroupBoundary(d.exp-i) {
+			dst = append(dst, c.GroupSeparator...)
+		}
+		dst = append(dst, d.digit(i))
+	}
+
+	if decimals > 0 {
+		dst = append(dst, c.DecimalSeparator...)
+		for i := 0; i < decimals; i++ {
+			dst = append(dst, d.digit(d.exp+i))
+		}
+	}
+
+	return dst
+}
+
+// Appends the absolute value as d.ddd followed by the exponent with at least minExpDigits digits
+func (d decimalNumber) appendScientific(dst []byte, decimals int, expChar byte, minExpDigits int, c *Culture) []byte {
+	dst = append(dst, d.digit(0))
+	if decimals > 0 {
+		dst = append(dst, c.DecimalSeparator...)
+		for i := 1; i <= decimals; i++ {
+			dst = append(dst, d.digit(i))
+		}
+	}
+
+	exp := 0
+	if !d.isZero() {
+		exp = d.exp - 1
+	}
+
+	return appendExponent(dst, exp, expChar, minExpDigits, true, c)
+}
+
+// Appends the exponent of the scientific notation. The positive sign is optional, the negative one is not.
+func appendExponent(dst []byte, exp int, expChar byte, minDigits int, positiveSign bool, c *Culture) []byte {
+	dst = append(dst, expChar)
+	if exp < 0 {
+		dst = append(dst, c.NegativeSign...)
+		exp = -exp
+	} else if positiveSign {
+		dst = append(dst, '+')
+	}
+
+	var expDigits [8]byte
+	formatted := strconv.AppendInt(expDigits[:0], int64(exp), 10)
+	for i := len(formatted); i < minDigits; i++ {
+		dst = append(dst, '0')
+	}
+
+	return append(dst, formatted...)
+}
+
+// Appends the absolute value in the shorter one of fixed-point and scientific notation for precision significant digits
+func (d decimalNumber) appendGeneral(dst []byte, precision int, expChar byte, c *Culture) []byte {
+	d = d.rounded(precision)
+	if d.isZero() {
+		return append(dst, '0')
+	}
+
+	exp := d.exp - 1
+	if exp > -5 && exp < precision {
+		decimals := len(d.digits) - d.exp
+		if decimals < 0 {
+			decimals = 0
+		}
+		return d.appendFixed(dst, decimals, false, c)
+	}
+
+	return d.appendScientific(dst, len(d.digits)-1, expChar, 2, c)
+}
+
+func (d decimalNumber) appendSign(dst []byte, c *Culture) []byte {
+	if d.negative {
+		dst = append(dst, c.NegativeSign...)
+	}
+
+	return dst
+}
+
+// Appends the grouped number with the given decimals by following a currency or percent pattern,
+// where 'n' stands for the number, '-' for the negative sign and '$' or '%' for the symbol.
+func (d decimalNumber) appendPattern(dst []byte, pattern string, symbol string, decimals int, c *Culture) []byte {
+	for i := 0; i < len(pattern); i++ {
+		switch pattern[i] {
+		case 'n':
+			dst = d.appendFixed(dst, decimals, true, c)
+		case '-':
+			dst = append(dst, c.NegativeSign...)
+		case '$', '%':
+			dst = append(dst, symbol...)
+		default:
+			dst = append(dst, pattern[i])
+		}
+	}
+
+	return dst
+}
+
+// Splits a standard numeric format like "N2" into its specifier and precision.
+// The precision is -1 if it is not given. Reports false if format is not a standard format.
+func parseStandardFormat(format string) (byte, int, bool) {
+	if len(format) == 0 {
+		return 'G', -1, true
+	}
+	specifier := format[0]
+	if !(specifier >= 'A' && specifier <= 'Z' || specifier >= 'a' && specifier <= 'z') || len(format) > 3 {
+		return 0, 0, false
+	}
+	if len(format) == 1 {
+		return specifier, -1, true
+	}
+
+	precision := 0
+	for _, c := range format[1:] {
+		if c < '0' || c > '9' {
+			return 0, 0, false
+		}
+		precision = precision*10 + int(c-'0')
+	}
+
+	return specifier, precision, true
+}
+
+// Appends an integer formatted with a .NET standard or custom numeric format. bitSize is used
+// for the two's complement of negative numbers in hexadecimal format.
+func appendFormattedInt(dst []byte, value int64, bitSize int, format string, c *Culture) ([]byte, error) {
+	specifier, precision, ok := parseStandardFormat(format)
+	if ok && (specifier == 'X' || specifier == 'x') {
+		bits := uint64(value)
+		if bitSize < 64 {
+			bits &= 1<<uint(bitSize) - 1
+		}
+		return appendHex(dst, bits, specifier, precision), nil
+	}
+
+	var buffer [24]byte
+	return appendFormattedDecimal(dst, decimalFromInt(buffer[:], value), true, 0, format, c)
+}
+
+// Appends an unsigned integer formatted with a .NET standard or custom numeric format
+func appendFormattedUint(dst []byte, value uint64, format string, c *Culture) ([]byte, error) {
+	specifier, precision, ok := parseStandardFormat(format)
+	if ok && (specifier == 'X' || specifier == 'x') {
+		return appendHex(dst, value, specifier, precision), nil
+	}
+
+	var buffer [24]byte
+	return appendFormattedDecimal(dst, decimalFromUint(buffer[:], value, false), true, 0, format, c)
+}
+
+// Appends a floating-point number formatted with a .NET standard or custom numeric format
+func appendFormattedFloat(dst []byte, value float64, bitSize int, format string, c *Culture) ([]byte, error) {
+	switch {
+	case math.IsNaN(value):
+		return append(dst, c.NaNSymbol...), nil
+	case math.IsInf(value, 1):
+		return append(dst, c.PositiveInfinitySymbol...), nil
+	case math.IsInf(value, -1):
+		return append(dst, c.NegativeInfinitySymbol...), nil
+	}
+
+	// Like .NET, "G" shows 15 significant digits for doubles and 7 for floats
+	generalPrecision := 15
+	if bitSize == 32 {
+		generalPrecision = 7
+	}
+
+	var buffer [32]byte
+	return appendFormattedDecimal(dst, decimalFromFloat(buffer[:], value, bitSize), false, generalPrecision, format, c)
+}
+
+// Formats d with a standard or custom numeric format. generalPrecision is the number of significant digits
+// the "G" format uses for floating-point numbers if no precision is given.
+func appendFormattedDecimal(dst []byte, d decimalNumber, isInteger bool, generalPrecision int, format string, c *Culture) ([]byte, error) {
+	specifier, precision, ok := parseStandardFormat(format)
+	if !ok {
+		return appendCustomNumber(dst, d, format, c), nil
+	}
+
+	switch specifier {
+	case 'C', 'c':
+		if precision < 0 {
+			precision = c.CurrencyDecimalDigits
+		}
+		d = d.rounded(d.exp + precision)
+		if d.negative {
+			return d.appendPattern(dst, currencyNegativePatterns[c.CurrencyNegativePattern], c.CurrencySymbol, precision, c), nil
+		}
+		return d.appendPattern(dst, currencyPositivePatterns[c.CurrencyPositivePattern], c.CurrencySymbol, precision, c), nil
+	case 'D', 'd':
+		if !isInteger {
+			return dst, fmt.Errorf("format %q is only supported for integers", format)
+		}
+		dst = d.appendSign(dst, c)
+		if d.isZero() && precision <= 0 {
+			return append(dst, '0'), nil
+		}
+		for i := d.exp; i < precision; i++ {
+			dst = append(dst, '0')
+		}
+		for i := 0; i < d.exp; i++ {
+			dst = append(dst, d.digit(i))
+		}
+		return dst, nil
+	case 'E', 'e':
+		if precision < 0 {
+			precision = 6
+		}
+		d = d.rounded(precision + 1)
+		return d.appendScientific(d.appendSign(dst, c), precision, specifier, 3, c), nil
+	case 'F', 'f', 'N', 'n':
+		if precision < 0 {
+			precision = c.NumberDecimalDigits
+		}
+		d = d.rounded(d.exp + precision)
+		return d.appendFixed(d.appendSign(dst, c), precision, specifier == 'N' || specifier == 'n', c), nil
+	case 'G', 'g':
+		expChar := byte('E')
+		if specifier == 'g' {
+			expChar = 'e'
+		}
+		if precision <= 0 {
+			precision = generalPrecision
+			if isInteger {
+				precision = len(d.digits)
+			}
+		}
+		dst = d.appendSign(dst, c)
+		return d.appendGeneral(dst, precision, expChar, c), nil
+	case 'P', 'p':
+		if precision < 0 {
+			precision = c.PercentDecimalDigits
+		}
+		if !d.isZero() {
+			d.exp += 2
+		}
+		d = d.rounded(d.exp + precision)
+		if d.negative {
+			return d.appendPattern(dst, percentNegativePatterns[c.PercentNegativePattern], c.PercentSymbol, precision, c), nil
+		}
+		return d.appendPattern(dst, percentPositivePatterns[c.PercentPositivePattern], c.PercentSymbol, precision, c), nil
+	case 'R', 'r':
+		if isInteger {
+			return dst, fmt.Errorf("format %q is only supported for floating-point numbers", format)
+		}
+		// The digits are already the shortest ones which round-trip
+		precision = len(d.digits)
+		if precision < generalPrecision {
+			precision = generalPrecision
+		}
+		return d.appendGeneral(d.appendSign(dst, c), precision, 'E', c), nil
+	case 'X', 'x':
+		return dst, fmt.Errorf("format %q is only supported for integers", format)
+	}
+
+	return dst, fmt.Errorf("format specifier %q is not supported", string(specifier))
+}
+
+func appendHex(dst []byte, value uint64, specifier byte, precision int) []byte {
+	var buffer [16]byte
+	hex := strconv.AppendUint(buffer[:0], value, 16)
+	for i := len(hex); i < precision; i++ {
+		dst = append(dst, '0')
+	}
+	for _, c := range hex {
+		if specifier == 'X' && c >= 'a' {
+			c -= 'a' - 'A'
+		}
+		dst = append(dst, c)
+	}
+
+	return dst
+}
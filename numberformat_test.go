@@ -0,0 +1,179 @@
+package Text
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAppendFormattedInt(t *testing.T) {
+	tests := []struct {
+		format string
+		value  int64
+		want   string
+	}{
+		{"", 0, "0"},
+		{"", -42, "-42"},
+		{"D", 42, "42"},
+		{"D5", 42, "00042"},
+		{"D5", -42, "-00042"},
+		{"X", 255, "FF"},
+		{"x4", 255, "00ff"},
+		{"N", 1234567, "1,234,567.00"},
+		{"N0", -1234567, "-1,234,567"},
+		{"F1", 5, "5.0"},
+		{"E2", 12345, "1.23E+004"},
+		{"e", 0, "0.000000e+000"},
+		{"G3", 12345, "1.23E+04"},
+		{"G", 1200, "1200"},
+		{"", math.MinInt64, "-9223372036854775808"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			got, err := appendFormattedInt(nil, tt.value, 64, tt.format, InvariantCulture)
+			if err != nil {
+				t.Fatalf("appendFormattedInt() threw an error: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("appendFormattedInt(%v, %q) = %q, want %q", tt.value, tt.format, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAppendFormattedFloat(t *testing.T) {
+	tests := []struct {
+		format string
+		value  float64
+		want   string
+	}{
+		{"", 0.1, "0.1"},
+		{"", 1.0 / 3, "0.333333333333333"},
+		{"", 1e20, "1E+20"},
+		{"", 0.00001, "1E-05"},
+		{"", 0.0001, "0.0001"},
+		{"F2", 2.345, "2.35"},
+		{"F2", -0.001, "0.00"},
+		{"F0", 2.5, "3"},
+		{"N2", 1234.567, "1,234.57"},
+		{"N2", 999.999, "1,000.00"},
+		{"E3", 0.00012345, "1.235E-004"},
+		{"G4", 123.456, "123.5"},
+		{"", math.NaN(), "NaN"},
+		{"", math.Inf(-1), "-Infinity"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			got, err := appendFormattedFloat(nil, tt.value, 64, tt.format, InvariantCulture)
+			if err != nil {
+				t.Fatalf("appendFormattedFloat() threw an error: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("appendFormattedFloat(%v, %q) = %q, want %q", tt.value, tt.format, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAppendFormattedFloat32UsesShortestDigits(t *testing.T) {
+	got, _ := appendFormattedFloat(nil, float64(float32(0.1)), 32, "", InvariantCulture)
+
+	if string(got) != "0.1" {
+		t.Errorf("appendFormattedFloat() = %q, want %q", got, "0.1")
+	}
+}
+
+func TestAppendCustomNumberFormat(t *testing.T) {
+	tests := []struct {
+		format string
+		value  float64
+		want   string
+	}{
+		{"#,##0.00", 1234.567, "1,234.57"},
+		{"#,##0.00", 0, "0.00"},
+		{"#,##0.00", -1234.5, "-1,234.50"},
+		{"#,##0.00;(#,##0.00)", -1234.5, "(1,234.50)"},
+		{"#;(#);zero", 0, "zero"},
+		{"0.00;-0.00;zero", 0.001, "zero"},
+		{"#;;zero", -5, "-5"},
+		{"#,##0,K", 1234567, "1,235K"},
+		{"0,,", 1234567890, "1235"},
+		{"0.0%", 0.1234, "12.3%"},
+		{"0‰", 0.5, "500‰"},
+		{"0.00E+00", 1234.5, "1.23E+03"},
+		{"0.0e0", 1234.5, "1.2e3"},
+		{"00.0E-00", 0.00012, "12.0E-05"},
+		{"00000000", 12345, "00012345"},
+		{"(###) ###-####", 5551234567, "(555) 123-4567"},
+		{"#.##", 1.5, "1.5"},
+		{"#.##", 0.5, ".5"},
+		{"0.##", 0.004, "0"},
+		{"#.##", 0, ""},
+		{"0.00##", 0.005, "0.005"},
+		{"'#'0", 42, "#42"},
+		{`\#0`, 42, "#42"},
+		{"0 'items'", 3, "3 items"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			got, err := appendFormattedFloat(nil, tt.value, 64, tt.format, InvariantCulture)
+			if err != nil {
+				t.Fatalf("appendFormattedFloat() threw an error: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("appendFormattedFloat(%v, %q) = %q, want %q", tt.value, tt.format, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAppendFormattedNumberWithCulture(t *testing.T) {
+	indian := *InvariantCulture
+	indian.GroupSizes = []int{3, 2}
+
+	tests := []struct {
+		name    string
+		culture *Culture
+		format  string
+		value   float64
+		want    string
+	}{
+		{"Invariant currency", InvariantCulture, "C", 1234.5, "¤1,234.50"},
+		{"Invariant percent", InvariantCulture, "P", 0.5, "50.00 %"},
+		{"Invariant negative percent", InvariantCulture, "P1", -0.1234, "-12.3 %"},
+		{"en-US currency", CultureEnUS, "C", 1234.5, "$1,234.50"},
+		{"en-US negative currency", CultureEnUS, "C", -1234.5, "($1,234.50)"},
+		{"en-US currency without decimals", CultureEnUS, "C0", 1234.5, "$1,235"},
+		{"de-DE number", CultureDeDE, "N2", 1234.5, "1.234,50"},
+		{"de-DE currency", CultureDeDE, "C", 1234.5, "1.234,50 €"},
+		{"de-DE negative currency", CultureDeDE, "C", -1234.5, "-1.234,50 €"},
+		{"de-DE percent", CultureDeDE, "P", 0.1234, "12,34 %"},
+		{"de-DE custom", CultureDeDE, "#,##0.00", 1234567.891, "1.234.567,89"},
+		{"de-DE scientific", CultureDeDE, "E2", 1234.5, "1,23E+003"},
+		{"tr-TR currency", CultureTrTR, "C", -1234.5, "-₺1.234,50"},
+		{"tr-TR percent", CultureTrTR, "P0", 0.25, "%25"},
+		{"Indian grouping", &indian, "N0", 1234567, "12,34,567"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := appendFormattedFloat(nil, tt.value, 64, tt.format, tt.culture)
+			if err != nil {
+				t.Fatalf("appendFormattedFloat() threw an error: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("appendFormattedFloat(%v, %q) = %q, want %q", tt.value, tt.format, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAppendFormattedNumberErrors(t *testing.T) {
+	if _, err := appendFormattedFloat(nil, 1.5, 64, "X", InvariantCulture); err == nil {
+		t.Errorf("appendFormattedFloat() should return an error for a hexadecimal format")
+	}
+	if _, err := appendFormattedInt(nil, 1, 64, "R", InvariantCulture); err == nil {
+		t.Errorf("appendFormattedInt() should return an error for a round-trip format")
+	}
+	if _, err := appendFormattedInt(nil, 1, 64, "K", InvariantCulture); err == nil {
+		t.Errorf("appendFormattedInt() should return an error for an unknown format specifier")
+	}
+}
@@ -0,0 +1,141 @@
+package Text
+
+import (
+	"math/bits"
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	// Capacity of the smallest size class. Every class doubles the capacity of the previous one.
+	minPooledCapacity = 64
+	poolSizeClasses   = 20
+	// Builders with a larger capacity are dropped by Return unless the limit is changed
+	DefaultMaxPooledCapacity = 1 << 16
+)
+
+// BuilderPool recycles StringBuilder instances to take pressure off the garbage collector.
+// Builders are kept in size classes, so a rented builder always has at least the requested capacity.
+// It is safe for concurrent use.
+type BuilderPool struct {
+	classes     [poolSizeClasses]sync.Pool
+	maxCapacity atomic.Int64
+	hits        atomic.Uint64
+	misses      atomic.Uint64
+	discards    atomic.Uint64
+}
+
+// PoolStats holds the counters of a BuilderPool
+type PoolStats struct {
+	// Number of Rent calls which reused a pooled builder
+	Hits uint64
+	// Number of Rent calls which had to allocate a new builder
+	Misses uint64
+	// Number of Return calls which dropped the builder instead of pooling it
+	Discards uint64
+}
+
+// DefaultPool is used by Rent and Return
+var DefaultPool = NewBuilderPool(DefaultMaxPooledCapacity)
+
+// Creates a new pool which drops returned builders with a capacity above maxCapacity runes
+func NewBuilderPool(maxCapacity int) *BuilderPool {
+	p := &BuilderPool{}
+	p.SetMaxCapacity(maxCapacity)
+
+	return p
+}
+
+// Returns an empty StringBuilder with a capacity of at least minCapacity runes from the default pool
+func Rent(minCapacity int) *StringBuilder {
+	return DefaultPool.Rent(minCapacity)
+}
+
+// Clears the StringBuilder and gives it back to the default pool. The builder must not be used afterwards.
+func Return(s *StringBuilder) {
+	DefaultPool.Return(s)
+}
+
+// Returns an empty StringBuilder with a capacity of at least minCapacity runes
+func (p *BuilderPool) Rent(minCapacity int) *StringBuilder {
+	class := sizeClassFor(minCapacity)
+	if class < poolSizeClasses {
+		if s, ok := p.classes[class].Get().(*StringBuilder); ok {
+			p.hits.Add(1)
+			return s
+		}
+		minCapacity = classCapacity(class)
+	}
+
+	p.misses.Add(1)
+
+	return NewStringBuilder(minCapacity)
+}
+
+// Clears the StringBuilder and gives it back to the pool. The builder must not be used afterwards.
+// Builders above the maximum capacity of the pool, or too small for any size class, are dropped.
+func (p *BuilderPool) Return(s *StringBuilder) {
+	if s == nil {
+		return
+	}
+
+	capacity := s.Capacity()
+	if capacity < minPooledCapacity || int64(capacity) > p.maxCapacity.Load() {
+		p.discards.Add(1)
+		return
+	}
+
+	// Everything of the previous owner is detached before the content is dropped, so nobody is notified
+	// about it and no edit is recorded
+	s.observers = nil
+	s.history = nil
+	s.journal = nil
+	s.markers = nil
+	s.lines = nil
+	s.culture = nil
+	s.maxCapacity = 0
+	s.growth = nil
+	s.position = 0
+	s.dropPending()
+	s.err = nil
+	// A snapshot or clone may still read the storage, the next owner must not write into it
+	s.unshare(0)
+
+	// The builder goes into the largest class it fully satisfies
+	class := sizeClassFor(capacity)
+	if classCapacity(class) > capacity {
+		class--
+	}
+	if class >= poolSizeClasses {
+		class = poolSizeClasses - 1
+	}
+	p.classes[class].Put(s)
+}
+
+// Sets the capacity in runes above which returned builders are dropped instead of pooled
+func (p *BuilderPool) SetMaxCapacity(maxCapacity int) {
+	p.maxCapacity.Store(int64(maxCapacity))
+}
+
+// Returns the maximum capacity of pooled builders
+func (p *BuilderPool) MaxCapacity() int {
+	return int(p.maxCapacity.Load())
+}
+
+// Returns the current hit, miss and discard counters
+func (p *BuilderPool) Stats() PoolStats {
+	return PoolStats{Hits: p.hits.Load(), Misses: p.misses.Load(), Discards: p.discards.Load()}
+}
+
+// Returns the smallest size class whose capacity is at least capacity
+func sizeClassFor(capacity int) int {
+	if capacity <= minPooledCapacity {
+		return 0
+	}
+
+	return bits.Len(uint(capacity-1)) - bits.Len(minPooledCapacity-1)
+}
+
+func classCapacity(class int) int {
+	return minPooledCapacity << class
+}
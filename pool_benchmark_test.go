@@ -0,0 +1,39 @@
+package Text
+
+import (
+	"testing"
+)
+
+func BenchmarkNewStringBuilderPerRequest(b *testing.B) {
+	b.ReportAllocs()
+	var r string
+	for n := 0; n < b.N; n++ {
+		s := NewStringBuilder(64)
+		s.Append(text).AppendInt(n)
+		r = s.ToString()
+	}
+	result = r
+}
+
+func BenchmarkRentReturnPerRequest(b *testing.B) {
+	b.ReportAllocs()
+	var r string
+	for n := 0; n < b.N; n++ {
+		s := Rent(64)
+		s.Append(text).AppendInt(n)
+		r = s.ToString()
+		Return(s)
+	}
+	result = r
+}
+
+func BenchmarkRentReturnParallel(b *testing.B) {
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			s := Rent(256)
+			s.Append(text)
+			Return(s)
+		}
+	})
+}
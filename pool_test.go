@@ -0,0 +1,123 @@
+package Text
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestRentReturnsEmptyBuilderWithCapacity(t *testing.T) {
+	p := NewBuilderPool(DefaultMaxPooledCapacity)
+
+	for _, capacity := range []int{0, 1, 64, 65, 1000, 1 << 16} {
+		s := p.Rent(capacity)
+		if s.Len() != 0 || len(s.data) < capacity {
+			t.Errorf("BuilderPool.Rent(%v) returned a builder with length %v and capacity %v", capacity, s.Len(), len(s.data))
+		}
+	}
+}
+
+func TestReturnedBuilderIsCleared(t *testing.T) {
+	p := NewBuilderPool(DefaultMaxPooledCapacity)
+	s := p.Rent(100)
+	s.Append("Hello").SetCulture(CultureDeDE)
+	p.Return(s)
+
+	// sync.Pool may drop items at any time, so only check what comes back if it is the same instance
+	rented := p.Rent(100)
+	if rented == s && (rented.Len() != 0 || rented.Culture() != InvariantCulture) {
+		t.Errorf("BuilderPool.Rent() returned a builder which wasn't cleared: %q", rented.ToString())
+	}
+}
+
+func TestReturnDoesNotNotifyThePreviousOwner(t *testing.T) {
+	p := NewBuilderPool(DefaultMaxPooledCapacity)
+	s := p.Rent(100)
+	s.EnableHistory(10).Append("Hello")
+	history := s.history
+	var events []ChangeEvent
+	s.OnChange(func(ev ChangeEvent) { events = append(events, ev) })
+
+	p.Return(s)
+
+	if len(events) != 0 {
+		t.Errorf("BuilderPool.Return() sent %v to the observers of the previous owner", events)
+	}
+	if len(history.undo) != 1 {
+		t.Errorf("BuilderPool.Return() recorded %v undo steps, want 1", len(history.undo))
+	}
+}
+
+func TestReturnDoesNotHandOutStorageOfSnapshots(t *testing.T) {
+	p := NewBuilderPool(DefaultMaxPooledCapacity)
+	s := p.Rent(100)
+	s.Append("Hello")
+	snapshot := s.Snapshot()
+
+	p.Return(s)
+
+	if s.frozen != 0 {
+		t.Errorf("Returned builder is still frozen up to %v", s.frozen)
+	}
+	s.Append("World")
+	if got := snapshot.ToString(); got != "Hello" {
+		t.Errorf("Snapshot after appending to the returned builder = %q, want %q", got, "Hello")
+	}
+}
+
+func TestReturnDiscardsOversizedBuilders(t *testing.T) {
+	p := NewBuilderPool(1024)
+
+	p.Return(NewStringBuilder(4096))
+	p.Return(NewStringBuilder(10))
+	p.Return(NewStringBuilder(512))
+
+	if got := p.Stats().Discards; got != 2 {
+		t.Errorf("BuilderPool.Stats().Discards = %v, want %v", got, 2)
+	}
+}
+
+func TestPoolCountsHitsAndMisses(t *testing.T) {
+	p := NewBuilderPool(DefaultMaxPooledCapacity)
+
+	const rounds = 100
+	for i := 0; i < rounds; i++ {
+		p.Return(p.Rent(200))
+	}
+
+	stats := p.Stats()
+	if stats.Hits+stats.Misses != rounds {
+		t.Errorf("BuilderPool.Stats() = %+v, want %v rents in total", stats, rounds)
+	}
+	if stats.Misses == 0 {
+		t.Errorf("BuilderPool.Stats().Misses = 0, but the first Rent can't be a hit")
+	}
+}
+
+func TestRentedBuilderFitsItsSizeClass(t *testing.T) {
+	p := NewBuilderPool(DefaultMaxPooledCapacity)
+
+	// 300 runes lands in the class for 256, so it must never be handed out for 512
+	p.Return(NewStringBuilder(300))
+	if s := p.Rent(512); len(s.data) < 512 {
+		t.Errorf("BuilderPool.Rent(512) returned a builder with capacity %v", len(s.data))
+	}
+}
+
+func TestPoolConcurrentUse(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				s := Rent(64 * (j%4 + 1))
+				s.AppendInt(i)
+				if s.Len() != 1 {
+					t.Errorf("Rent() returned a builder which is used elsewhere")
+				}
+				Return(s)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
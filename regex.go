@@ -0,0 +1,90 @@
+package Text
+
+import "regexp"
+
+// Returns the rune offsets [start, end) of the leftmost match of re in the string builder or nil if there is none
+func (s *StringBuilder) FindRegex(re *regexp.Regexp) []int {
+	loc := re.FindStringIndex(s.ToString())
+	if loc == nil {
+		return nil
+	}
+
+	c := byteCursor{runes: s.runes()}
+	return []int{c.runeOffset(loc[0]), c.runeOffset(loc[1])}
+}
+
+// Returns the rune offsets [start, end) of up to n successive matches of re. A negative n returns all matches.
+// Returns nil if there is no match.
+func (s *StringBuilder) FindAllRegex(re *regexp.Regexp, n int) [][]int {
+	locs := re.FindAllStringIndex(s.ToString(), n)
+	c := byteCursor{runes: s.runes()}
+	for _, loc := range locs {
+		loc[0], loc[1] = c.runeOffset(loc[0]), c.runeOffset(loc[1])
+	}
+
+	return locs
+}
+
+// Replaces all matches of re with the template, in which $1 or ${name} stand for the text of a submatch
+// like in regexp.Regexp.Expand. Markers and the undo history are kept like for Replace.
+func (s *StringBuilder) ReplaceRegex(re *regexp.Regexp, template string) *StringBuilder {
+	return s.replaceRegex(re, func(text string, match []int) []rune {
+		return []rune(string(re.ExpandString(nil, template, text, match)))
+	})
+}
+
+// Replaces all matches of re with the result of replace. The first element of match is the whole match,
+// followed by the submatches. Submatches which didn't take part in the match are empty.
+func (s *StringBuilder) ReplaceRegexFunc(re *regexp.Regexp, replace func(match []string) string) *StringBuilder {
+	return s.replaceRegex(re, func(text string, match []int) []rune {
+		submatches := make([]string, len(match)/2)
+		for i := range submatches {
+			if match[2*i] >= 0 {
+				submatches[i] = text[match[2*i]:match[2*i+1]]
+			}
+		}
+		return []rune(replace(submatches))
+	})
+}
+
+// Replaces every match of re with the runes returned by expand, which gets the submatch byte offsets
+func (s *StringBuilder) replaceRegex(re *regexp.Regexp, expand func(text string, match []int) []rune) *StringBuilder {
+	text := s.ToString()
+	matches := re.FindAllStringSubmatchIndex(text, -1)
+	if len(matches) == 0 {
+		return s
+	}
+
+	c := byteCursor{runes: s.runes()}
+	replacements := make([]replacement, 0, len(matches))
+	for _, match := range matches {
+		inserted := expand(text, match)
+		// Empty matches with an empty replacement would only record empty edits
+		if match[0] == match[1] && len(inserted) == 0 {
+			continue
+		}
+		replacements = append(replacements, replacement{c.runeOffset(match[0]), c.runeOffset(match[1]), inserted})
+	}
+
+	if err := s.replaceRanges(replacements, ChangeReplace); err != nil {
+		s.setErr(err)
+	}
+
+	return s
+}
+
+// Translates ascending byte offsets of the UTF-8 encoded runes into rune offsets in a single pass
+type byteCursor struct {
+	runes      []rune
+	byteOffset int
+	index      int
+}
+
+func (c *byteCursor) runeOffset(byteOffset int) int {
+	for c.byteOffset < byteOffset {
+		c.byteOffset += unitLen(c.runes[c.index], UTF8)
+		c.index++
+	}
+
+	return c.index
+}
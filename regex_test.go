@@ -0,0 +1,172 @@
+package Text
+
+import (
+	"errors"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestFindRegex(t *testing.T) {
+	s := NewStringBuilderFromString("Grüße 2024, \U0001F600 42")
+
+	got := s.FindRegex(regexp.MustCompile(`\d+`))
+
+	if !reflect.DeepEqual(got, []int{6, 10}) {
+		t.Errorf("FindRegex() = %v, want [6 10]", got)
+	}
+	if got[0] != s.FindFirst("2024") {
+		t.Errorf("FindRegex() starts at %d, FindFirst() at %d", got[0], s.FindFirst("2024"))
+	}
+}
+
+func TestFindRegexWithoutMatch(t *testing.T) {
+	s := NewStringBuilderFromString("Hello")
+
+	if got := s.FindRegex(regexp.MustCompile(`\d`)); got != nil {
+		t.Errorf("FindRegex() = %v, want nil", got)
+	}
+	if got := s.FindAllRegex(regexp.MustCompile(`\d`), -1); got != nil {
+		t.Errorf("FindAllRegex() = %v, want nil", got)
+	}
+}
+
+func TestFindAllRegex(t *testing.T) {
+	s := NewStringBuilderFromString("Grüße 2024, \U0001F600 42")
+	re := regexp.MustCompile(`\d+`)
+
+	all := s.FindAllRegex(re, -1)
+	first := s.FindAllRegex(re, 1)
+
+	if !reflect.DeepEqual(all, [][]int{{6, 10}, {14, 16}}) {
+		t.Errorf("FindAllRegex(-1) = %v, want [[6 10] [14 16]]", all)
+	}
+	if !reflect.DeepEqual(first, [][]int{{6, 10}}) {
+		t.Errorf("FindAllRegex(1) = %v, want [[6 10]]", first)
+	}
+}
+
+func TestFindRegexAfterInvalidRune(t *testing.T) {
+	s := NewStringBuilder(0).AppendRune(0xD800).Append("ab")
+
+	got := s.FindRegex(regexp.MustCompile(`b`))
+
+	if !reflect.DeepEqual(got, []int{2, 3}) {
+		t.Errorf("FindRegex() = %v, want [2 3]", got)
+	}
+}
+
+func TestReplaceRegexMatchesRegexp(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		pattern  string
+		template string
+	}{
+		{"Numbered groups", "a=1, b=22", `(\w+)=(\d+)`, "$2:$1"},
+		{"Named groups", "a=1, b=22", `(?P<key>\w+)=(?P<value>\d+)`, "${value}=${key}"},
+		{"Multi-byte runes", "Grüße, \U0001F600 Straße", `ß(e?)`, "ss$1"},
+		{"Empty matches", "abc", `x*`, "-"},
+		{"Growing", "aaa", `a`, "bbb"},
+		{"Shrinking", "aaa bbb", `\w+`, "x"},
+		{"Unknown group", "ab", `(a)`, "[$2]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			re := regexp.MustCompile(tt.pattern)
+			s := NewStringBuilderFromString(tt.text)
+
+			s.ReplaceRegex(re, tt.template)
+
+			want := re.ReplaceAllString(tt.text, tt.template)
+			if s.ToString() != want {
+				t.Errorf("Got %q, want %q", s.ToString(), want)
+			}
+		})
+	}
+}
+
+func TestReplaceRegexKeepsMarkersAndHistory(t *testing.T) {
+	s := NewStringBuilderFromString("x = 1; y = 22; end", WithHistory(10))
+	marker, _ := s.Mark(15)
+
+	s.ReplaceRegex(regexp.MustCompile(`(\w) = (\d+)`), "$1 := $2")
+
+	if s.ToString() != "x := 1; y := 22; end" {
+		t.Errorf("Got %q", s.ToString())
+	}
+	if marker.Position() != 17 {
+		t.Errorf("Marker is at %d, want 17", marker.Position())
+	}
+	s.Undo()
+	if s.ToString() != "x = 1; y = 22; end" {
+		t.Errorf("Got %q after Undo", s.ToString())
+	}
+}
+
+func TestReplaceRegexEvents(t *testing.T) {
+	s := NewStringBuilderFromString("\U0001F600 a1 b2")
+	var events []ChangeEvent
+	s.OnChange(func(ev ChangeEvent) { events = append(events, ev) })
+
+	s.ReplaceRegex(regexp.MustCompile(`\d`), "#")
+	s.ReplaceRegex(regexp.MustCompile(`z*`), "")
+
+	want := []ChangeEvent{
+		{ChangeReplace, 3, 4, "1", "#"},
+		{ChangeReplace, 6, 7, "2", "#"},
+	}
+	if !reflect.DeepEqual(events, want) {
+		t.Errorf("Got events %+q, want %+q", events, want)
+	}
+}
+
+func TestReplaceRegexFunc(t *testing.T) {
+	s := NewStringBuilderFromString("a-b-ä")
+	var matches [][]string
+
+	s.ReplaceRegexFunc(regexp.MustCompile(`(a)|(b)|ä`), func(match []string) string {
+		matches = append(matches, match)
+		return strings.ToUpper(match[0])
+	})
+
+	if s.ToString() != "A-B-Ä" {
+		t.Errorf("Got %q, want %q", s.ToString(), "A-B-Ä")
+	}
+	want := [][]string{{"a", "a", ""}, {"b", "", "b"}, {"ä", "", ""}}
+	if !reflect.DeepEqual(matches, want) {
+		t.Errorf("Got matches %q, want %q", matches, want)
+	}
+}
+
+func TestReplaceRegexExceedingMaxCapacity(t *testing.T) {
+	s := NewStringBuilderFromString("aaaa", WithMaxCapacity(6))
+
+	s.ReplaceRegex(regexp.MustCompile(`a`), "bb")
+
+	if s.ToString() != "aaaa" {
+		t.Errorf("Got %q, want the content unchanged", s.ToString())
+	}
+	if !errors.Is(s.Err(), ErrCapacityExceeded) {
+		t.Errorf("Err() = %v, want ErrCapacityExceeded", s.Err())
+	}
+}
+
+func TestConcurrentStringBuilderRegex(t *testing.T) {
+	s := NewConcurrentStringBuilderFromString("ä1 ö2")
+	re := regexp.MustCompile(`\d`)
+
+	s.ReplaceRegex(re, "<$0>")
+
+	if got := s.FindAllRegex(re, -1); !reflect.DeepEqual(got, [][]int{{2, 3}, {7, 8}}) {
+		t.Errorf("FindAllRegex() = %v, want [[2 3] [7 8]]", got)
+	}
+	if got := s.FindRegex(re); !reflect.DeepEqual(got, []int{2, 3}) {
+		t.Errorf("FindRegex() = %v, want [2 3]", got)
+	}
+	s.ReplaceRegexFunc(re, func(match []string) string { return "n" })
+	if s.ToString() != "ä<n> ö<n>" {
+		t.Errorf("Got %q, want %q", s.ToString(), "ä<n> ö<n>")
+	}
+}
@@ -0,0 +1,82 @@
+package Text
+
+// A single replacement of the runes in [start, end) with text
+type replacement struct {
+	start int
+	end   int
+	text  []rune
+}
+
+// Applies all replacements in a single pass. The replacements have to be sorted by start and must not overlap.
+// If no replacement makes the text grow before it was read, the result is written in place.
+// Otherwise it is written into one new backing array. Fails without any change if the result exceeds the maximum capacity.
+func (s *StringBuilder) replaceRanges(replacements []replacement, kind ChangeKind) error {
+	if len(replacements) == 0 {
+		return nil
+	}
+
+	newLen := s.position
+	inPlace := true
+	for _, r := range replacements {
+		newLen += len(r.text) - (r.end - r.start)
+		// The write position is ahead of the read position, so later runes would get overwritten
+		if newLen > s.position {
+			inPlace = false
+		}
+	}
+
+	first := replacements[0].start
+	s.dropPending()
+	if inPlace {
+		s.unshare(first)
+	}
+	target := s.data
+	if !inPlace {
+		capacity, err := s.newCapacity(newLen)
+		if err != nil {
+			return err
+		}
+		target = make([]rune, capacity)
+		copy(target, s.data[:first])
+	}
+
+	var edits []edit
+	if s.tracking() {
+		// Edits are replayed one after another, so each start has to include the shift of the previous ones
+		edits = make([]edit, len(replacements))
+		shift := 0
+		for i, r := range replacements {
+			removed := make([]rune, r.end-r.start)
+			copy(removed, s.data[r.start:r.end])
+			edits[i] = edit{kind: kind, start: r.start + shift, removed: removed, inserted: r.text}
+			shift += len(r.text) - (r.end - r.start)
+		}
+	}
+
+	written := first
+	for i, r := range replacements {
+		written += copy(target[written:], r.text)
+
+		nextStart := s.position
+		if i+1 < len(replacements) {
+			nextStart = replacements[i+1].start
+		}
+		written += copy(target[written:], s.data[r.end:nextStart])
+	}
+
+	if !inPlace {
+		s.frozen = 0
+	}
+	s.data = target
+	s.position = newLen
+
+	if edits != nil {
+		s.beginEdit()
+		for _, e := range edits {
+			s.record(e)
+		}
+		s.endEdit()
+	}
+
+	return nil
+}
@@ -0,0 +1,398 @@
+package Text
+
+// Maximum number of runes a single leaf of a Rope holds
+const ropeMaxLeaf = 1024
+
+// Rope is a text buffer for large documents. It keeps its content in a balanced binary tree
+// of rune chunks, so Insert, Remove and RuneAt only touch O(log n) nodes instead of shifting
+// the whole buffer like StringBuilder does.
+type Rope struct {
+	root *ropeNode
+}
+
+// ropeNode is either a leaf holding runes or an inner node with two children.
+// The tree is kept height balanced (AVL) on every join.
+type ropeNode struct {
+	left, right *ropeNode
+	runes       []rune
+	length      int
+	height      int
+}
+
+// Creates a new, empty Rope
+func NewRope() *Rope {
+	return &Rope{}
+}
+
+// Creates a new instance of the Rope with a preallocated text
+func NewRopeFromString(text string) *Rope {
+	return &Rope{root: buildRope([]rune(text))}
+}
+
+// Appends a text to the Rope instance
+func (s *Rope) Append(text string) *Rope {
+	s.appendRunes([]rune(text))
+
+	return s
+}
+
+// Appends a text and a new line character to the Rope instance
+func (s *Rope) AppendLine(text string) *Rope {
+	s.Append(text)
+	s.Append("\n")
+
+	return s
+}
+
+// Appends a single character to the Rope instance
+func (s *Rope) AppendRune(char rune) *Rope {
+	s.appendRunes([]rune{char})
+
+	return s
+}
+
+// Returns the current length of the represented string
+func (s *Rope) Len() int {
+	return s.root.len()
+}
+
+// Returns the represented string
+func (s *Rope) ToString() string {
+	return string(s.runes())
+}
+
+// Removes all characters from the current instance
+func (s *Rope) Clear() {
+	s.root = nil
+}
+
+// Removes length runes starting at start
+func (s *Rope) Remove(start int, length int) error {
+	if err := checkRange("Remove", start, length, s.Len()); err != nil {
+		return err
+	}
+
+	if length == 0 {
+		return nil
+	}
+
+	if s.root.removeInPlace(start, length) {
+		return nil
+	}
+
+	left, rest := s.root.split(start)
+	_, right := rest.split(length)
+	s.root = joinRopes(left, right)
+
+	return nil
+}
+
+// Inserts the text at the given index
+func (s *Rope) Insert(index int, text string) error {
+	if err := checkIndex("Insert", index, s.Len(), true); err != nil {
+		return err
+	}
+
+	runeText := []rune(text)
+	if len(runeText) == 0 {
+		return nil
+	}
+
+	if s.root != nil && s.root.insertInPlace(index, runeText) {
+		return nil
+	}
+
+	left, right := s.root.split(index)
+	s.root = joinRopes(joinRopes(left, buildRope(runeText)), right)
+
+	return nil
+}
+
+// Gets the rune at the specific position. Panics with a *RangeError if the index is out of range.
+func (s *Rope) RuneAt(index int) rune {
+	r, err := s.TryRuneAt(index)
+	if err != nil {
+		panic(err)
+	}
+
+	return r
+}
+
+// Gets the rune at the specific position or returns a *RangeError if the index is out of range
+func (s *Rope) TryRuneAt(index int) (rune, error) {
+	if err := checkIndex("RuneAt", index, s.Len(), false); err != nil {
+		return 0, err
+	}
+
+	n := s.root
+	for n.runes == nil {
+		if index < n.left.length {
+			n = n.left
+		} else {
+			index -= n.left.length
+			n = n.right
+		}
+	}
+
+	return n.runes[index], nil
+}
+
+// Returns a substring from start (inclusive) to end (exclusive).
+func (s *Rope) Substring(start, end int) (string, error) {
+	if err := checkBounds("Substring", start, end, s.Len()); err != nil {
+		return "", err
+	}
+
+	r := make([]rune, 0, end-start)
+	return string(s.root.collect(start, end, r)), nil
+}
+
+// Returns the first occurrence of the given text in the rope. Returns -1 if not found
+func (s *Rope) FindFirst(text string, opts ...SearchOption) int {
+	return findFirst(s.runes(), text, newSearchOptions(opts))
+}
+
+// Returns the last occurrence of the given text in the rope. Returns -1 if not found
+func (s *Rope) FindLast(text string, opts ...SearchOption) int {
+	return findLast(s.runes(), text, newSearchOptions(opts))
+}
+
+// Returns all occurrences of the given text in the rope. Returns an empty if no occurrence found.
+func (s *Rope) FindAll(text string, opts ...SearchOption) []int {
+	return findAll(s.runes(), text, newSearchOptions(opts))
+}
+
+// Replaces all occurrences of oldValue with newValue
+func (s *Rope) Replace(oldValue string, newValue string) *Rope {
+	if oldValue == newValue {
+		return s
+	}
+
+	runes := s.runes()
+	occurrences := findAll(runes, oldValue, searchOptions{})
+	if len(occurrences) == 0 {
+		return s
+	}
+
+	oldLen := len([]rune(oldValue))
+	newValueRunes := []rune(newValue)
+	result := make([]rune, 0, len(runes)+len(occurrences)*(len(newValueRunes)-oldLen))
+	last := 0
+	for _, occurrence := range occurrences {
+		result = append(result, runes[last:occurrence]...)
+		result = append(result, newValueRunes...)
+		last = occurrence + oldLen
+	}
+	result = append(result, runes[last:]...)
+	s.root = buildRope(result)
+
+	return s
+}
+
+func (s *Rope) runes() []rune {
+	return s.root.collect(0, s.Len(), make([]rune, 0, s.Len()))
+}
+
+func (s *Rope) appendRunes(runes []rune) {
+	if len(runes) == 0 {
+		return
+	}
+	if s.root != nil && s.root.insertInPlace(s.root.length, runes) {
+		return
+	}
+
+	s.root = joinRopes(s.root, buildRope(runes))
+}
+
+func (n *ropeNode) len() int {
+	if n == nil {
+		return 0
+	}
+	return n.length
+}
+
+func (n *ropeNode) depth() int {
+	if n == nil {
+		return -1
+	}
+	return n.height
+}
+
+func newLeaf(runes []rune) *ropeNode {
+	return &ropeNode{runes: runes, length: len(runes)}
+}
+
+func newNode(left, right *ropeNode) *ropeNode {
+	height := left.depth()
+	if right.depth() > height {
+		height = right.depth()
+	}
+
+	return &ropeNode{left: left, right: right, length: left.length + right.length, height: height + 1}
+}
+
+// Builds a perfectly balanced tree out of the given runes. The leaves take ownership of the slice.
+func buildRope(runes []rune) *ropeNode {
+	if len(runes) == 0 {
+		return nil
+	}
+	if len(runes) <= ropeMaxLeaf {
+		return newLeaf(runes)
+	}
+
+	leaves := (len(runes) + ropeMaxLeaf - 1) / ropeMaxLeaf
+	mid := (leaves / 2) * ropeMaxLeaf
+
+	return newNode(buildRope(runes[:mid:mid]), buildRope(runes[mid:]))
+}
+
+// Concatenates two trees and restores the balance along the way
+func joinRopes(left, right *ropeNode) *ropeNode {
+	if left.len() == 0 {
+		return right
+	}
+	if right.len() == 0 {
+		return left
+	}
+
+	if left.runes != nil && right.runes != nil && left.length+right.length <= ropeMaxLeaf {
+		merged := make([]rune, 0, left.length+right.length)
+		merged = append(merged, left.runes...)
+		return newLeaf(append(merged, right.runes...))
+	}
+
+	if left.height > right.height+1 {
+		return newNode(left.left, joinRopes(left.right, right)).balance()
+	}
+	if right.height > left.height+1 {
+		return newNode(joinRopes(left, right.left), right.right).balance()
+	}
+
+	return newNode(left, right)
+}
+
+// Splits the tree into the first index runes and the rest
+func (n *ropeNode) split(index int) (*ropeNode, *ropeNode) {
+	if n == nil || index <= 0 {
+		return nil, n
+	}
+	if index >= n.length {
+		return n, nil
+	}
+
+	if n.runes != nil {
+		return newLeaf(n.runes[:index:index]), newLeaf(n.runes[index:])
+	}
+
+	if index < n.left.length {
+		left, right := n.left.split(index)
+		return left, joinRopes(right, n.right)
+	}
+
+	left, right := n.right.split(index - n.left.length)
+	return joinRopes(n.left, left), right
+}
+
+func (n *ropeNode) balance() *ropeNode {
+	switch diff := n.left.depth() - n.right.depth(); {
+	case diff > 1:
+		if n.left.left.depth() < n.left.right.depth() {
+			n = newNode(n.left.rotateLeft(), n.right)
+		}
+		return n.rotateRight()
+	case diff < -1:
+		if n.right.right.depth() < n.right.left.depth() {
+			n = newNode(n.left, n.right.rotateRight())
+		}
+		return n.rotateLeft()
+	}
+
+	return n
+}
+
+func (n *ropeNode) rotateLeft() *ropeNode {
+	return newNode(newNode(n.left, n.right.left), n.right.right)
+}
+
+func (n *ropeNode) rotateRight() *ropeNode {
+	return newNode(n.left.left, newNode(n.left.right, n.right))
+}
+
+// Inserts the runes in place into the leaf holding index if they still fit into it.
+// Reports whether the insert happened.
+func (n *ropeNode) insertInPlace(index int, runes []rune) bool {
+	if n.runes != nil {
+		if n.length+len(runes) > ropeMaxLeaf {
+			return false
+		}
+		n.runes = append(n.runes, runes...)
+		copy(n.runes[index+len(runes):], n.runes[index:n.length])
+		copy(n.runes[index:], runes)
+		n.length += len(runes)
+		return true
+	}
+
+	var inserted bool
+	if index <= n.left.length {
+		inserted = n.left.insertInPlace(index, runes)
+	} else {
+		inserted = n.right.insertInPlace(index-n.left.length, runes)
+	}
+	if inserted {
+		n.length += len(runes)
+	}
+
+	return inserted
+}
+
+// Removes the range in place if it lies inside a single leaf and doesn't empty it.
+// Reports whether the removal happened.
+func (n *ropeNode) removeInPlace(start, length int) bool {
+	if n.runes != nil {
+		if length >= n.length {
+			return false
+		}
+		n.runes = append(n.runes[:start], n.runes[start+length:]...)
+		n.length -= length
+		return true
+	}
+
+	var removed bool
+	if start+length <= n.left.length {
+		removed = n.left.removeInPlace(start, length)
+	} else if start >= n.left.length {
+		removed = n.right.removeInPlace(start-n.left.length, length)
+	}
+	if removed {
+		n.length -= length
+	}
+
+	return removed
+}
+
+// Appends the runes in [start, end) of the tree to dst
+func (n *ropeNode) collect(start, end int, dst []rune) []rune {
+	if n == nil || start >= end {
+		return dst
+	}
+	if n.runes != nil {
+		return append(dst, n.runes[start:end]...)
+	}
+
+	if start < n.left.length {
+		leftEnd := end
+		if leftEnd > n.left.length {
+			leftEnd = n.left.length
+		}
+		dst = n.left.collect(start, leftEnd, dst)
+	}
+	if end > n.left.length {
+		rightStart := start - n.left.length
+		if rightStart < 0 {
+			rightStart = 0
+		}
+		dst = n.right.collect(rightStart, end-n.left.length, dst)
+	}
+
+	return dst
+}
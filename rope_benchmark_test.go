@@ -0,0 +1,48 @@
+package Text
+
+import (
+	"strings"
+	"testing"
+)
+
+var document = strings.Repeat("Lorem ipsum dolor sit amet, consectetur adipiscing elit. ", 20000)
+
+func BenchmarkRopeInsertRemoveMiddle(b *testing.B) {
+	r := NewRopeFromString(document)
+	middle := r.Len() / 2
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		r.Insert(middle, text)
+		r.Remove(middle, len(text))
+	}
+}
+
+func BenchmarkStringBuilderInsertRemoveMiddle(b *testing.B) {
+	s := NewStringBuilderFromString(document)
+	middle := s.Len() / 2
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		s.Insert(middle, text)
+		s.Remove(middle, len(text))
+	}
+}
+
+func BenchmarkRopeInsertSpread(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		r := NewRopeFromString(document)
+		for i := 0; i < 1000; i++ {
+			r.Insert((i*7919)%r.Len(), text)
+		}
+	}
+}
+
+func BenchmarkStringBuilderInsertSpread(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		s := NewStringBuilderFromString(document)
+		for i := 0; i < 1000; i++ {
+			s.Insert((i*7919)%s.Len(), text)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package Text
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestRopeAppendAndInsert(t *testing.T) {
+	const expected string = "Hello my dear and beautiful Wörld\n"
+	r := NewRope()
+
+	r.Append("Hello").AppendRune(' ').AppendLine("Wörld")
+	if err := r.Insert(5, " my dear and beautiful"); err != nil {
+		t.Errorf("Insert threw an error: %v", err)
+	}
+
+	if result := r.ToString(); result != expected {
+		t.Errorf("Actual %q, Expected: %q", result, expected)
+	}
+	if result := r.Len(); result != 34 {
+		t.Errorf("Rope.Len() = %v, want %v", result, 34)
+	}
+}
+
+func TestRopeBoundsErrors(t *testing.T) {
+	r := NewRopeFromString("Hello")
+
+	if err := r.Remove(4, 2); err == nil {
+		t.Error("Remove should throw error but did not")
+	}
+	if err := r.Remove(-1, 1); err == nil {
+		t.Error("Remove should throw error but did not")
+	}
+	if err := r.Insert(-1, "a"); err == nil {
+		t.Error("Insert should throw error but did not")
+	}
+	if err := r.Insert(6, "a"); err == nil {
+		t.Error("Insert should throw error but did not")
+	}
+	if _, err := r.Substring(3, 2); err == nil {
+		t.Error("Substring should throw error but did not")
+	}
+}
+
+func TestRopeFindAndReplace(t *testing.T) {
+	r := NewRopeFromString(strings.Repeat("a", 3000) + "Hällo" + strings.Repeat("b", 3000) + "Hällo")
+
+	if got := r.FindFirst("Hällo"); got != 3000 {
+		t.Errorf("Rope.FindFirst() = %v, want %v", got, 3000)
+	}
+	if got := r.FindLast("Hällo"); got != 6005 {
+		t.Errorf("Rope.FindLast() = %v, want %v", got, 6005)
+	}
+	if got := r.FindAll("Hällo"); !slicesEqual(got, []int{3000, 6005}) {
+		t.Errorf("Rope.FindAll() = %v, want %v", got, []int{3000, 6005})
+	}
+
+	r.Replace("Hällo", "Hi")
+
+	if got := r.Len(); got != 6004 {
+		t.Errorf("Rope.Len() = %v, want %v", got, 6004)
+	}
+	if got, _ := r.Substring(2998, 3004); got != "aaHibb" {
+		t.Errorf("Rope.Substring() = %q, want %q", got, "aaHibb")
+	}
+}
+
+func TestRopeReplaceOverlapping(t *testing.T) {
+	r := NewRopeFromString("aaaa")
+
+	r.Replace("aa", "b")
+
+	if got := r.ToString(); got != "bb" {
+		t.Errorf("Rope.Replace() = %q, want %q", got, "bb")
+	}
+}
+
+func TestRopeMatchesStringBuilderAfterRandomEdits(t *testing.T) {
+	random := rand.New(rand.NewSource(42))
+	r := NewRopeFromString(strings.Repeat("Grüße 東京 ", 500))
+	sb := NewStringBuilderFromString(r.ToString())
+
+	for i := 0; i < 2000; i++ {
+		index := random.Intn(sb.Len() + 1)
+		if random.Intn(3) == 0 && index < sb.Len() {
+			length := random.Intn(sb.Len()-index) / 4
+			r.Remove(index, length)
+			sb.Remove(index, length)
+		} else {
+			text := strings.Repeat("x😀", random.Intn(700))
+			r.Insert(index, text)
+			sb.Insert(index, text)
+		}
+	}
+
+	if got, want := r.ToString(), sb.ToString(); got != want {
+		t.Fatalf("Rope diverged from StringBuilder")
+	}
+	for i := 0; i < sb.Len(); i += 97 {
+		if r.RuneAt(i) != sb.RuneAt(i) {
+			t.Fatalf("RuneAt(%d) mismatch", i)
+		}
+	}
+	assertRopeBalanced(t, r.root)
+}
+
+func TestRopeStaysBalancedWhenAppending(t *testing.T) {
+	r := NewRope()
+
+	for i := 0; i < 5000; i++ {
+		r.Append(strings.Repeat("a", 700))
+	}
+
+	assertRopeBalanced(t, r.root)
+	if got := r.Len(); got != 3500000 {
+		t.Errorf("Rope.Len() = %v, want %v", got, 3500000)
+	}
+}
+
+func assertRopeBalanced(t *testing.T, n *ropeNode) {
+	t.Helper()
+	if n == nil || n.runes != nil {
+		return
+	}
+
+	if diff := n.left.depth() - n.right.depth(); diff > 1 || diff < -1 {
+		t.Fatalf("Rope is not balanced: left height %d, right height %d", n.left.depth(), n.right.depth())
+	}
+	if n.length != n.left.length+n.right.length {
+		t.Fatalf("Rope length %d doesn't match children %d + %d", n.length, n.left.length, n.right.length)
+	}
+
+	assertRopeBalanced(t, n.left)
+	assertRopeBalanced(t, n.right)
+}
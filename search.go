@@ -1,80 +1,378 @@
 package Text
 
+// Needles with at least this many runes are searched with Boyer-Moore-Horspool.
+// Shorter needles can't skip much, so Knuth-Morris-Pratt with its tiny setup cost wins there.
+const horspoolMinNeedleLength = 8
+
+// Configures how the text is searched
+type SearchOption func(*searchOptions)
+
+type searchOptions struct {
+	overlapping bool
+	canonical   bool
+	comparison  StringComparison
+	// Culture of the searched text for CultureIgnoreCase
+	culture *Culture
+}
+
+// Reports occurrences which overlap each other. Searching "aa" in "aaaa" finds 0, 1 and 2.
+// This is the default for FindAll.
+func Overlapping() SearchOption {
+	return func(o *searchOptions) {
+		o.overlapping = true
+	}
+}
+
+// Skips occurrences which overlap a previous one. Searching "aa" in "aaaa" finds 0 and 2.
+func NonOverlapping() SearchOption {
+	return func(o *searchOptions) {
+		o.overlapping = false
+	}
+}
+
+// Matches canonically equivalent text, so "é" written as U+00E9 also finds 'e' followed by U+0301.
+// The NFD of the text and of the needle are compared, so an occurrence can have another length than the needle.
+// Occurrences never split a rune or leave a combining mark behind them.
+func CanonicalEquivalence() SearchOption {
+	return func(o *searchOptions) {
+		o.canonical = true
+	}
+}
+
+func newSearchOptions(opts []SearchOption) searchOptions {
+	o := searchOptions{overlapping: true}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return o
+}
+
 // Returns all occurences of needle in haystack
-func findAll(haystack []rune, needle string) []int {
+func findAll(haystack []rune, needle string, o searchOptions) []int {
+	if o.transforming() {
+		return occurrenceStarts(findTransformed(haystack, needle, o, -1))
+	}
+
+	return findAllRunes(haystack, []rune(needle), o.overlapping, -1)
+}
+
+// An occurrence of a needle from start (inclusive) to end (exclusive)
+type occurrence struct {
+	start int
+	end   int
+}
+
+// Returns up to limit occurrences of needle in haystack. A negative limit returns all occurrences.
+func findOccurrences(haystack []rune, needle string, o searchOptions, limit int) []occurrence {
+	if o.transforming() {
+		return findTransformed(haystack, needle, o, limit)
+	}
+
+	needleRunes := []rune(needle)
+	starts := findAllRunes(haystack, needleRunes, o.overlapping, limit)
+	occurrences := make([]occurrence, len(starts))
+	for i, start := range starts {
+		occurrences[i] = occurrence{start, start + len(needleRunes)}
+	}
+
+	return occurrences
+}
+
+// Returns the first of the occurrences or one with start -1 if there is none
+func firstOccurrence(occurrences []occurrence) occurrence {
+	if len(occurrences) == 0 {
+		return occurrence{-1, -1}
+	}
+
+	return occurrences[0]
+}
 
-	needleAsRunes := []rune(needle)
-	lenNeedle := len(needleAsRunes)
+// Returns the last of the occurrences or one with start -1 if there is none
+func lastOccurrence(occurrences []occurrence) occurrence {
+	if len(occurrences) == 0 {
+		return occurrence{-1, -1}
+	}
+
+	return occurrences[len(occurrences)-1]
+}
+
+func occurrenceStarts(occurrences []occurrence) []int {
+	starts := make([]int, len(occurrences))
+	for i, o := range occurrences {
+		starts[i] = o.start
+	}
+
+	return starts
+}
+
+// Returns the first occurrence of haystack in needle or -1 if not found.
+func findFirst(haystack []rune, needle string, o searchOptions) int {
+	if o.transforming() {
+		return firstOccurrence(findTransformed(haystack, needle, o, 1)).start
+	}
+
+	return newMatcher([]rune(needle)).index(haystack, 0)
+}
+
+// Returns the last occurrence of haystack in needle or -1 if not found.
+func findLast(haystack []rune, needle string, o searchOptions) int {
+	return findLastOccurrence(haystack, needle, o).start
+}
+
+// Returns the last occurrence of needle in haystack or one with start -1 if not found
+func findLastOccurrence(haystack []rune, needle string, o searchOptions) occurrence {
+	if o.transforming() {
+		o.overlapping = true
+		return lastOccurrence(findTransformed(haystack, needle, o, -1))
+	}
 
-	if len(haystack) == 0 || len(needle) == 0 || lenNeedle > len(haystack) {
-		return []int{}
+	needleRunes := []rune(needle)
+	start := lastIndexKmp(haystack, needleRunes)
+	if start == -1 {
+		return occurrence{-1, -1}
 	}
 
+	return occurrence{start, start + len(needleRunes)}
+}
+
+// Returns up to limit occurrences of needle in haystack. A negative limit returns all occurrences.
+func findAllRunes(haystack []rune, needle []rune, overlapping bool, limit int) []int {
 	items := make([]int, 0, 8)
+	if len(needle) == 0 || limit == 0 {
+		return items
+	}
 
-	for i := 0; i < len(haystack); i++ {
-		for j := 0; j < lenNeedle; j++ {
-			if haystack[i+j] != needleAsRunes[j] {
-				break
-			}
+	m := newMatcher(needle)
+	if overlapping || m.horspool == nil {
+		return m.allKmp(haystack, overlapping, limit, items)
+	}
 
-			if j == lenNeedle-1 {
-				items = append(items, i)
-			}
+	// Horspool restarts behind every occurrence. As occurrences don't overlap, nothing is read twice.
+	for from := 0; ; {
+		index := m.index(haystack, from)
+		if index == -1 {
+			return items
 		}
+		items = append(items, index)
+		if len(items) == limit {
+			return items
+		}
+		from = index + len(needle)
 	}
+}
 
-	return items
+// A matcher searches one needle in any number of haystacks.
+// The search algorithm is picked based on the length of the needle.
+type matcher struct {
+	needle []rune
+	// Failure function of Knuth-Morris-Pratt, always present as Horspool falls back to it
+	kmp []int
+	// Bad character shifts of Boyer-Moore-Horspool, only present for long needles
+	horspool *horspoolTable
 }
 
-// Returns the first occurrence of haystack in needle or -1 if not found.
-func findFirst(haystack []rune, needle string) int {
-	needleAsRunes := []rune(needle)
-	lenNeedle := len(needleAsRunes)
+type horspoolTable struct {
+	ascii        [128]int
+	other        map[rune]int
+	needleLength int
+}
 
-	if len(haystack) == 0 || len(needle) == 0 || lenNeedle > len(haystack) {
-		return -1
+func newMatcher(needle []rune) *matcher {
+	m := &matcher{needle: needle, kmp: kmpTable(needle)}
+	if len(needle) >= horspoolMinNeedleLength {
+		m.horspool = newHorspoolTable(needle)
 	}
 
-	lenHaystack := len(haystack)
+	return m
+}
 
-	for i := 0; i <= lenHaystack-lenNeedle; i++ {
-		for j := 0; j < lenNeedle; j++ {
-			if haystack[i+j] != needleAsRunes[j] {
-				break
-			}
+// Returns the first occurrence of the needle in haystack starting at from or -1 if not found
+func (m *matcher) index(haystack []rune, from int) int {
+	if len(m.needle) == 0 || from < 0 || len(haystack)-from < len(m.needle) {
+		return -1
+	}
 
-			if j == lenNeedle-1 {
+	switch {
+	case len(m.needle) == 1:
+		for i := from; i < len(haystack); i++ {
+			if haystack[i] == m.needle[0] {
 				return i
 			}
 		}
+		return -1
+	case m.horspool != nil:
+		return m.indexHorspool(haystack, from)
+	default:
+		return m.indexKmp(haystack, from)
+	}
+}
+
+// Knuth-Morris-Pratt search, O(n+m) in the worst case
+func (m *matcher) indexKmp(haystack []rune, from int) int {
+	matched := 0
+	for i := from; i < len(haystack); i++ {
+		for matched > 0 && haystack[i] != m.needle[matched] {
+			matched = m.kmp[matched-1]
+		}
+		if haystack[i] == m.needle[matched] {
+			matched++
+		}
+		if matched == len(m.needle) {
+			return i - matched + 1
+		}
 	}
 
 	return -1
 }
 
-// Returns the last occurrence of haystack in needle or -1 if not found.
-func findLast(haystack []rune, needle string) int {
-	needleAsRunes := []rune(needle)
-	lenNeedle := len(needleAsRunes)
-
-	if len(haystack) == 0 || len(needle) == 0 || lenNeedle > len(haystack) {
-		return -1
+// Advances a Knuth-Morris-Pratt search by the rune r and returns the new number of matched runes.
+// This allows searching text which isn't stored in a single slice.
+func (m *matcher) step(matched int, r rune) int {
+	for matched > 0 && r != m.needle[matched] {
+		matched = m.kmp[matched-1]
+	}
+	if r == m.needle[matched] {
+		matched++
 	}
 
-	lenHaystack := len(haystack)
+	return matched
+}
 
-	for i := lenHaystack - lenNeedle; i >= 0; i-- {
-		for j := 0; j < lenNeedle; j++ {
-			if haystack[i+j] != needleAsRunes[j] {
-				break
+// Appends up to limit occurrences to items with a single Knuth-Morris-Pratt pass over the haystack.
+// After an overlapping occurrence the search continues with the longest border instead of starting over.
+func (m *matcher) allKmp(haystack []rune, overlapping bool, limit int, items []int) []int {
+	matched := 0
+	for i := 0; i < len(haystack); i++ {
+		for matched > 0 && haystack[i] != m.needle[matched] {
+			matched = m.kmp[matched-1]
+		}
+		if haystack[i] == m.needle[matched] {
+			matched++
+		}
+		if matched == len(m.needle) {
+			items = append(items, i-matched+1)
+			if len(items) == limit {
+				return items
 			}
-
-			if j == lenNeedle-1 {
-				return i
+			if overlapping {
+				matched = m.kmp[matched-1]
+			} else {
+				matched = 0
 			}
 		}
 	}
 
+	return items
+}
+
+// Boyer-Moore-Horspool search, sublinear on average. Inputs like "aaaa...a" can push Horspool
+// towards O(n*m), so once it compared more than a few runes per position it continues with KMP.
+func (m *matcher) indexHorspool(haystack []rune, from int) int {
+	last := len(m.needle) - 1
+	budget := 4*len(m.needle) + 64
+
+	for i := from; i+last < len(haystack); {
+		j := last
+		for j >= 0 && haystack[i+j] == m.needle[j] {
+			j--
+		}
+		if j < 0 {
+			return i
+		}
+
+		budget -= last - j + 1
+		if budget < 0 {
+			return m.indexKmp(haystack, i)
+		}
+		budget += 2
+
+		i += m.horspool.shift(haystack[i+last])
+	}
+
+	return -1
+}
+
+func (t *horspoolTable) shift(r rune) int {
+	if r >= 0 && r < 128 {
+		return t.ascii[r]
+	}
+	if shift, ok := t.other[r]; ok {
+		return shift
+	}
+
+	// Runes which are not part of the needle allow a shift by the whole needle length
+	return t.needleLength
+}
+
+func newHorspoolTable(needle []rune) *horspoolTable {
+	t := &horspoolTable{other: make(map[rune]int), needleLength: len(needle)}
+	for i := range t.ascii {
+		t.ascii[i] = len(needle)
+	}
+
+	last := len(needle) - 1
+	for i, r := range needle[:last] {
+		if r >= 0 && r < 128 {
+			t.ascii[r] = last - i
+		} else {
+			t.other[r] = last - i
+		}
+	}
+
+	return t
+}
+
+// Returns the failure function of the needle: table[i] is the length of the longest proper prefix
+// of needle[:i+1] which is also a suffix of it
+func kmpTable(needle []rune) []int {
+	table := make([]int, len(needle))
+	matched := 0
+	for i := 1; i < len(needle); i++ {
+		for matched > 0 && needle[i] != needle[matched] {
+			matched = table[matched-1]
+		}
+		if needle[i] == needle[matched] {
+			matched++
+		}
+		table[i] = matched
+	}
+
+	return table
+}
+
+// Knuth-Morris-Pratt search from the end of the haystack, returns the last occurrence of needle or -1
+func lastIndexKmp(haystack []rune, needle []rune) int {
+	n, m := len(haystack), len(needle)
+	if m == 0 || m > n {
+		return -1
+	}
+
+	// Failure function of the reversed needle
+	table := make([]int, m)
+	matched := 0
+	for i := 1; i < m; i++ {
+		for matched > 0 && needle[m-1-i] != needle[m-1-matched] {
+			matched = table[matched-1]
+		}
+		if needle[m-1-i] == needle[m-1-matched] {
+			matched++
+		}
+		table[i] = matched
+	}
+
+	matched = 0
+	for i := n - 1; i >= 0; i-- {
+		for matched > 0 && haystack[i] != needle[m-1-matched] {
+			matched = table[matched-1]
+		}
+		if haystack[i] == needle[m-1-matched] {
+			matched++
+		}
+		if matched == m {
+			return i
+		}
+	}
+
 	return -1
 }
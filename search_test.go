@@ -0,0 +1,150 @@
+package Text
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestFindAllNonOverlapping(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		needle string
+		want   []int
+	}{
+		{"Empty haystack", "", "n", []int{}},
+		{"Empty needle", "n", "", []int{}},
+		{"Overlapping occurrences", "aaaa", "aa", []int{0, 2}},
+		{"Separated occurrences", "abcabc", "bc", []int{1, 4}},
+		{"Long needle", "abcdefghXabcdefghabcdefgh", "abcdefgh", []int{0, 9, 17}},
+		{"Long overlapping needle", strings.Repeat("a", 20), "aaaaaaaa", []int{0, 8}},
+		{"Umlauts", "ööööö", "öö", []int{0, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewStringBuilderFromString(tt.input)
+			if got := s.FindAll(tt.needle, NonOverlapping()); !slicesEqual(got, tt.want) {
+				t.Errorf("StringBuilder.FindAll() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFindAllOverlappingIsDefault(t *testing.T) {
+	s := NewStringBuilderFromString("aaaaaaaaaa")
+
+	explicit := s.FindAll("aaaaaaaa", Overlapping())
+	implicit := s.FindAll("aaaaaaaa")
+
+	if want := []int{0, 1, 2}; !slicesEqual(explicit, want) || !slicesEqual(implicit, want) {
+		t.Errorf("StringBuilder.FindAll() = %v and %v, want %v", explicit, implicit, want)
+	}
+}
+
+func TestFindWithLongNeedle(t *testing.T) {
+	haystack := strings.Repeat("Grüße aus Köln, ", 50) + "Grüße aus München" + strings.Repeat("!", 10)
+	s := NewStringBuilderFromString(haystack)
+
+	if got := s.FindFirst("Grüße aus München"); got != 800 {
+		t.Errorf("StringBuilder.FindFirst() = %v, want %v", got, 800)
+	}
+	if got := s.FindLast("Grüße aus Köln"); got != 784 {
+		t.Errorf("StringBuilder.FindLast() = %v, want %v", got, 784)
+	}
+}
+
+func TestFindFallsBackToLinearSearch(t *testing.T) {
+	haystack := []rune(strings.Repeat("a", 100000) + "baaaaaaaaa")
+	needle := []rune("baaaaaaaaa")
+
+	if got := newMatcher(needle).index(haystack, 0); got != 100000 {
+		t.Errorf("matcher.index() = %v, want %v", got, 100000)
+	}
+	needle = []rune("aaaaaaaaac")
+	if got := newMatcher(needle).index(haystack, 0); got != -1 {
+		t.Errorf("matcher.index() = %v, want %v", got, -1)
+	}
+}
+
+func FuzzFindFirst(f *testing.F) {
+	addSearchSeeds(f)
+	f.Fuzz(func(t *testing.T, haystack string, needle string) {
+		decodedHaystack, decodedNeedle := string([]rune(haystack)), string([]rune(needle))
+		if len(decodedNeedle) == 0 {
+			return
+		}
+
+		want := runeIndex(decodedHaystack, strings.Index(decodedHaystack, decodedNeedle))
+		if got := NewStringBuilderFromString(haystack).FindFirst(needle); got != want {
+			t.Errorf("FindFirst(%q, %q) = %v, want %v", haystack, needle, got, want)
+		}
+	})
+}
+
+func FuzzFindLast(f *testing.F) {
+	addSearchSeeds(f)
+	f.Fuzz(func(t *testing.T, haystack string, needle string) {
+		decodedHaystack, decodedNeedle := string([]rune(haystack)), string([]rune(needle))
+		if len(decodedNeedle) == 0 {
+			return
+		}
+
+		want := runeIndex(decodedHaystack, strings.LastIndex(decodedHaystack, decodedNeedle))
+		if got := NewStringBuilderFromString(haystack).FindLast(needle); got != want {
+			t.Errorf("FindLast(%q, %q) = %v, want %v", haystack, needle, got, want)
+		}
+	})
+}
+
+func FuzzFindAll(f *testing.F) {
+	addSearchSeeds(f)
+	f.Fuzz(func(t *testing.T, haystack string, needle string) {
+		decodedHaystack, decodedNeedle := string([]rune(haystack)), string([]rune(needle))
+		if len(decodedNeedle) == 0 {
+			return
+		}
+		_, firstRuneSize := utf8.DecodeRuneInString(decodedNeedle)
+		s := NewStringBuilderFromString(haystack)
+
+		if got, want := s.FindAll(needle), allIndices(decodedHaystack, decodedNeedle, firstRuneSize); !slicesEqual(got, want) {
+			t.Errorf("FindAll(%q, %q) = %v, want %v", haystack, needle, got, want)
+		}
+		if got, want := s.FindAll(needle, NonOverlapping()), allIndices(decodedHaystack, decodedNeedle, len(decodedNeedle)); !slicesEqual(got, want) {
+			t.Errorf("FindAll(%q, %q, NonOverlapping()) = %v, want %v", haystack, needle, got, want)
+		}
+	})
+}
+
+func addSearchSeeds(f *testing.F) {
+	f.Add("Hello World", "World")
+	f.Add("aaaa", "aa")
+	f.Add("Hellöö", "ö")
+	f.Add(strings.Repeat("abcabcabd", 5), "abcabd")
+	f.Add(strings.Repeat("a", 50)+"b", "aaaaaaaaab")
+	f.Add("汉字汉字汉字 and some more text", "汉字 and some")
+	f.Add("\xff\xfe invalid", "\xfe")
+}
+
+// Returns all rune indices of needle in haystack, continuing step bytes behind every occurrence
+func allIndices(haystack, needle string, step int) []int {
+	items := []int{}
+	for from := 0; from <= len(haystack); {
+		index := strings.Index(haystack[from:], needle)
+		if index < 0 {
+			break
+		}
+		items = append(items, runeIndex(haystack, from+index))
+		from += index + step
+	}
+
+	return items
+}
+
+func runeIndex(s string, byteIndex int) int {
+	if byteIndex < 0 {
+		return -1
+	}
+
+	return utf8.RuneCountInString(s[:byteIndex])
+}
@@ -0,0 +1,132 @@
+package Text
+
+import (
+	"io"
+	"strings"
+)
+
+// Snapshot is a read-only view of the content a StringBuilder had when the snapshot was taken.
+// It shares the memory with the StringBuilder, which copies its content only when it would overwrite
+// the shared part. A Snapshot never changes, so it can be handed to other goroutines without locking.
+type Snapshot struct {
+	runes   []rune
+	culture *Culture
+}
+
+// Returns a read-only view of the current content without copying it. Later changes to the
+// StringBuilder don't show up in the snapshot.
+func (s *StringBuilder) Snapshot() *Snapshot {
+	if s.frozen < s.position {
+		s.frozen = s.position
+	}
+
+	return &Snapshot{runes: s.data[:s.position:s.position], culture: s.Culture()}
+}
+
+// Returns a copy of the StringBuilder with the same content and settings. Both share the memory
+// until one of them changes its content. The undo history and open transactions are not copied.
+func (s *StringBuilder) Clone() *StringBuilder {
+	s.frozen = len(s.data)
+
+	return &StringBuilder{
+		data:        s.data,
+		position:    s.position,
+		pending:     s.pending,
+		culture:     s.culture,
+		maxCapacity: s.maxCapacity,
+		growth:      s.growth,
+		err:         s.err,
+		frozen:      len(s.data),
+	}
+}
+
+// Copies the internal array if runes from index on are shared with a clone or snapshot,
+// so writing there doesn't change them
+func (s *StringBuilder) unshare(index int) {
+	if index >= s.frozen {
+		return
+	}
+
+	data := make([]rune, len(s.data))
+	copy(data, s.data[:s.position])
+	s.data = data
+	s.frozen = 0
+}
+
+// Returns the search options with the culture of the StringBuilder the snapshot was taken from
+func (s *Snapshot) searchOptions(opts []SearchOption) searchOptions {
+	o := newSearchOptions(opts)
+	o.culture = s.culture
+
+	return o
+}
+
+// Returns the length of the snapshot
+func (s *Snapshot) Len() int {
+	return len(s.runes)
+}
+
+// Returns the content of the snapshot
+func (s *Snapshot) ToString() string {
+	return string(s.runes)
+}
+
+// Gets the rune at the specific position. Panics with a *RangeError if the index is out of range.
+func (s *Snapshot) RuneAt(index int) rune {
+	r, err := s.TryRuneAt(index)
+	if err != nil {
+		panic(err)
+	}
+
+	return r
+}
+
+// Gets the rune at the specific position or returns a *RangeError if the index is out of range
+func (s *Snapshot) TryRuneAt(index int) (rune, error) {
+	if err := checkIndex("RuneAt", index, len(s.runes), false); err != nil {
+		return 0, err
+	}
+
+	return s.runes[index], nil
+}
+
+// Returns a substring from start (inclusive) to end (exclusive).
+func (s *Snapshot) Substring(start, end int) (string, error) {
+	if err := checkBounds("Substring", start, end, len(s.runes)); err != nil {
+		return "", err
+	}
+
+	return string(s.runes[start:end]), nil
+}
+
+// Returns the first occurrence of the given text in the snapshot. Returns -1 if not found
+func (s *Snapshot) FindFirst(text string, opts ...SearchOption) int {
+	return findFirst(s.runes, text, s.searchOptions(opts))
+}
+
+// Returns the last occurrence of the given text in the snapshot. Returns -1 if not found
+func (s *Snapshot) FindLast(text string, opts ...SearchOption) int {
+	return findLast(s.runes, text, s.searchOptions(opts))
+}
+
+// Returns all occurrences of the given text in the snapshot. Returns an empty if no occurrence found.
+// Occurrences which overlap each other are reported unless the NonOverlapping option is given.
+func (s *Snapshot) FindAll(text string, opts ...SearchOption) []int {
+	return findAll(s.runes, text, s.searchOptions(opts))
+}
+
+// Implements the io.WriterTo interface. Writes the content as UTF-8 to w
+func (s *Snapshot) WriteTo(w io.Writer) (int64, error) {
+	return writeRunesTo(w, s.runes)
+}
+
+// Returns a reader over the content of the snapshot
+func (s *Snapshot) NewReader() *strings.Reader {
+	return strings.NewReader(s.ToString())
+}
+
+// Returns a new StringBuilder with the content of the snapshot. It shares the memory with the snapshot
+// until it gets changed.
+func (s *Snapshot) ToBuilder() *StringBuilder {
+	return &StringBuilder{data: s.runes, position: len(s.runes), frozen: len(s.runes)}
+}
@@ -0,0 +1,134 @@
+package Text
+
+import (
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestSnapshotIsNotAffectedByChanges(t *testing.T) {
+	tests := []struct {
+		name string
+		edit func(s *StringBuilder)
+	}{
+		{"Append", func(s *StringBuilder) { s.Append(" and more") }},
+		{"Append after TrimEnd", func(s *StringBuilder) { s.TrimEnd().Append("!!!!") }},
+		{"Append after Remove", func(s *StringBuilder) { s.Remove(0, 10); s.Append("??????????") }},
+		{"Write after Clear", func(s *StringBuilder) { s.Clear(); s.Write([]byte("Something else")) }},
+		{"Insert", func(s *StringBuilder) { s.Insert(2, "my dear ") }},
+		{"Replace", func(s *StringBuilder) { s.Replace("l", "L") }},
+		{"Replace with longer", func(s *StringBuilder) { s.Replace("l", "LLL") }},
+		{"ReplaceRune", func(s *StringBuilder) { s.ReplaceRune('o', '0') }},
+		{"TrimStart", func(s *StringBuilder) { s.TrimStart() }},
+		{"Reverse", func(s *StringBuilder) { s.Reverse() }},
+		{"SetRuneAt", func(s *StringBuilder) { s.SetRuneAt(2, 'J') }},
+		{"AsRuneSlice", func(s *StringBuilder) { s.AsRuneSlice()[2] = 'J' }},
+		{"Undo", func(s *StringBuilder) { s.Undo() }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			const text = "  Hello World, hello Gophers  "
+			s := NewStringBuilder(64, WithHistory(10))
+			s.Append(text)
+			snapshot := s.Snapshot()
+
+			tt.edit(s)
+
+			if got := snapshot.ToString(); got != text {
+				t.Errorf("Snapshot.ToString() = %q, want %q", got, text)
+			}
+		})
+	}
+}
+
+func TestSnapshotSharesMemoryWithAppends(t *testing.T) {
+	s := NewStringBuilder(64)
+	s.Append("Hello")
+	snapshot := s.Snapshot()
+
+	s.Append(" World")
+
+	if &s.data[0] != &snapshot.runes[0] {
+		t.Errorf("Appending copied the content although the snapshot isn't affected")
+	}
+	if s.ToString() != "Hello World" || snapshot.ToString() != "Hello" {
+		t.Errorf("StringBuilder = %q, Snapshot = %q, want %q and %q", s.ToString(), snapshot.ToString(), "Hello World", "Hello")
+	}
+}
+
+func TestSnapshotReadMethods(t *testing.T) {
+	snapshot := NewStringBuilderFromString("Hällo World, Hällo Gophers").Snapshot()
+
+	if got := snapshot.FindAll("Hällo"); !slicesEqual(got, []int{0, 13}) {
+		t.Errorf("Snapshot.FindAll() = %v, want %v", got, []int{0, 13})
+	}
+	if got := snapshot.FindFirst("World"); got != 6 {
+		t.Errorf("Snapshot.FindFirst() = %v, want %v", got, 6)
+	}
+	if got := snapshot.FindLast("Hällo"); got != 13 {
+		t.Errorf("Snapshot.FindLast() = %v, want %v", got, 13)
+	}
+	if got, _ := snapshot.Substring(6, 11); got != "World" {
+		t.Errorf("Snapshot.Substring() = %q, want %q", got, "World")
+	}
+	if got := snapshot.RuneAt(1); got != 'ä' {
+		t.Errorf("Snapshot.RuneAt() = %q, want %q", got, 'ä')
+	}
+	if _, err := snapshot.TryRuneAt(26); err == nil {
+		t.Error("TryRuneAt should throw error but did not")
+	}
+	var output strings.Builder
+	snapshot.WriteTo(&output)
+	if output.String() != "Hällo World, Hällo Gophers" || snapshot.Len() != 26 {
+		t.Errorf("Snapshot.WriteTo() = %q, want %q", output.String(), "Hällo World, Hällo Gophers")
+	}
+}
+
+func TestCloneIsIndependent(t *testing.T) {
+	s := NewStringBuilder(64, WithMaxCapacity(100))
+	s.Append("Hello")
+
+	clone := s.Clone()
+	clone.Append(" World")
+	s.Append(" Gophers")
+
+	if s.ToString() != "Hello Gophers" || clone.ToString() != "Hello World" {
+		t.Errorf("StringBuilder = %q, clone = %q, want %q and %q", s.ToString(), clone.ToString(), "Hello Gophers", "Hello World")
+	}
+	if clone.MaxCapacity() != 100 {
+		t.Errorf("Clone().MaxCapacity() = %v, want %v", clone.MaxCapacity(), 100)
+	}
+}
+
+func TestSnapshotToBuilder(t *testing.T) {
+	s := NewStringBuilderFromString("Hello")
+	snapshot := s.Snapshot()
+
+	copied := snapshot.ToBuilder().Append(" World")
+
+	if snapshot.ToString() != "Hello" || copied.ToString() != "Hello World" {
+		t.Errorf("Snapshot = %q, builder = %q, want %q and %q", snapshot.ToString(), copied.ToString(), "Hello", "Hello World")
+	}
+}
+
+func TestConcurrentSnapshotCanBeReadWhileWriting(t *testing.T) {
+	s := NewConcurrentStringBuilder(16)
+	var wg sync.WaitGroup
+
+	for i := 0; i < 100; i++ {
+		s.Append("ab")
+		snapshot := s.Snapshot()
+		wg.Add(1)
+		go func(expected int) {
+			defer wg.Done()
+			if snapshot.Len() != expected || len(snapshot.FindAll("ab")) != expected/2 {
+				t.Errorf("Snapshot has length %v, want %v", snapshot.Len(), expected)
+			}
+		}(s.Len())
+		s.Replace("ab", "ab")
+		s.Reverse()
+		s.Reverse()
+	}
+
+	wg.Wait()
+}
@@ -0,0 +1,317 @@
+package Text
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"unicode/utf8"
+)
+
+// SpillingStringBuilder is a StringBuilder for outputs which might not fit into memory.
+// The content is kept in memory until it passes a threshold. Then it is moved to a temporary file
+// and every following threshold runes are appended to that file as well. Small outputs never touch the disk.
+// Reads like WriteTo, FindFirst and Substring transparently cover the file and the memory.
+// Call Close to delete the temporary file.
+type SpillingStringBuilder struct {
+	memory    StringBuilder
+	threshold int
+	dir       string
+	file      *os.File
+	fileRunes int
+	fileBytes int64
+	// Where every spill started in the file, so reads can seek close to a rune offset
+	blocks []spillBlock
+	// First error of a chainable call or of writing to the file
+	err error
+}
+
+type spillBlock struct {
+	runeOffset int
+	byteOffset int64
+}
+
+// Creates a new instance of the SpillingStringBuilder which keeps up to threshold runes in memory.
+// The temporary file is created in dir, or in the default directory for temporary files if dir is empty.
+func NewSpillingStringBuilder(threshold int, dir string) *SpillingStringBuilder {
+	return &SpillingStringBuilder{threshold: threshold, dir: dir}
+}
+
+// Appends a text to the SpillingStringBuilder instance
+func (s *SpillingStringBuilder) Append(text string) *SpillingStringBuilder {
+	s.memory.Append(text)
+	s.spill()
+
+	return s
+}
+
+// Appends a text and a new line character to the SpillingStringBuilder instance
+func (s *SpillingStringBuilder) AppendLine(text string) *SpillingStringBuilder {
+	s.memory.AppendLine(text)
+	s.spill()
+
+	return s
+}
+
+// Appends a single character to the SpillingStringBuilder instance. The temporary file holds UTF-8,
+// so runes which aren't valid Unicode scalar values like surrogates are appended as utf8.RuneError.
+// That way the content is the same before and after it is spilled.
+func (s *SpillingStringBuilder) AppendRune(char rune) *SpillingStringBuilder {
+	if !utf8.ValidRune(char) {
+		char = utf8.RuneError
+	}
+	s.memory.AppendRune(char)
+	s.spill()
+
+	return s
+}
+
+// Appends a single integer to the SpillingStringBuilder instance
+func (s *SpillingStringBuilder) AppendInt(integer int) *SpillingStringBuilder {
+	return s.Append(strconv.Itoa(integer))
+}
+
+// Appends a single boolean to the SpillingStringBuilder instance
+func (s *SpillingStringBuilder) AppendBool(flag bool) *SpillingStringBuilder {
+	return s.Append(strconv.FormatBool(flag))
+}
+
+// Appends the composite format string with every format item replaced by the corresponding argument, see StringBuilder.AppendFormat
+func (s *SpillingStringBuilder) AppendFormat(format string, args ...any) error {
+	if err := s.memory.AppendFormat(format, args...); err != nil {
+		return err
+	}
+	s.spill()
+
+	return s.err
+}
+
+// Sets the culture used to format numbers and dates. nil selects the InvariantCulture.
+func (s *SpillingStringBuilder) SetCulture(culture *Culture) *SpillingStringBuilder {
+	s.memory.SetCulture(culture)
+
+	return s
+}
+
+// Implements the io.Writer interface so the SpillingStringBuilder can be used with fmt.Fprintf.
+// An incomplete rune at the end of p is added as utf8.RuneError for every byte and replaced once the next Write
+// completes it. If something else was added in between, the bytes stay utf8.RuneError.
+func (s *SpillingStringBuilder) Write(p []byte) (int, error) {
+	n, _ := s.memory.Write(p)
+	s.spill()
+	if s.err != nil {
+		return n, s.err
+	}
+
+	return n, nil
+}
+
+// Implements the io.StringWriter interface. Returns the number of bytes of text
+func (s *SpillingStringBuilder) WriteString(text string) (int, error) {
+	s.Append(text)
+	if s.err != nil {
+		return len(text), s.err
+	}
+
+	return len(text), nil
+}
+
+// Returns the error of the first write to or read from the temporary file which failed. Once that happened
+// the content is incomplete and all further appends are dropped.
+func (s *SpillingStringBuilder) Err() error {
+	return s.err
+}
+
+// Reports whether the content was moved to a temporary file
+func (s *SpillingStringBuilder) Spilled() bool {
+	return s.file != nil
+}
+
+// Returns the current length of the represented string
+func (s *SpillingStringBuilder) Len() int {
+	return s.fileRunes + s.memory.Len()
+}
+
+// Implements the io.WriterTo interface. Copies the temporary file and then the content in memory to w
+func (s *SpillingStringBuilder) WriteTo(w io.Writer) (int64, error) {
+	var total int64
+	if s.file != nil {
+		n, err := io.Copy(w, io.NewSectionReader(s.file, 0, s.fileBytes))
+		total += n
+		if err != nil {
+			return total, err
+		}
+	}
+
+	n, err := s.memory.WriteTo(w)
+
+	return total + n, err
+}
+
+// Returns the first occurrence of the given text in the SpillingStringBuilder. Returns -1 if not found
+// or if the temporary file can't be read, in which case Err reports why. With CanonicalEquivalence or
+// WithComparison the whole content is read into memory, as it has to be transformed as a whole.
+func (s *SpillingStringBuilder) FindFirst(text string, opts ...SearchOption) int {
+	if o := s.memory.searchOptions(opts); o.transforming() {
+		content := make([]rune, 0, s.Len())
+		err := s.each(0, func(position int, r rune) bool {
+			content = append(content, r)
+			return true
+		})
+		if err != nil {
+			s.setErr(err)
+			return -1
+		}
+		return findFirst(content, text, o)
+	}
+
+	needle := []rune(text)
+	if len(needle) == 0 {
+		return -1
+	}
+
+	m := &matcher{needle: needle, kmp: kmpTable(needle)}
+	found, matched := -1, 0
+	err := s.each(0, func(position int, r rune) bool {
+		matched = m.step(matched, r)
+		if matched == len(needle) {
+			found = position - matched + 1
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		s.setErr(err)
+		return -1
+	}
+
+	return found
+}
+
+// Returns a substring from start (inclusive) to end (exclusive). Parts in the temporary file are read from it.
+func (s *SpillingStringBuilder) Substring(start, end int) (string, error) {
+	if err := checkBounds("Substring", start, end, s.Len()); err != nil {
+		return "", err
+	}
+
+	r := make([]rune, 0, end-start)
+	err := s.each(start, func(position int, char rune) bool {
+		if position >= end {
+			return false
+		}
+		r = append(r, char)
+		return true
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return string(r), nil
+}
+
+// Removes all characters and deletes the temporary file
+func (s *SpillingStringBuilder) Clear() {
+	s.Close()
+}
+
+// Deletes the temporary file and clears the SpillingStringBuilder. It can be used again afterwards.
+func (s *SpillingStringBuilder) Close() error {
+	var err error
+	if s.file != nil {
+		err = s.file.Close()
+		if removeErr := os.Remove(s.file.Name()); err == nil {
+			err = removeErr
+		}
+	}
+
+	s.memory.Clear()
+	s.file = nil
+	s.fileRunes = 0
+	s.fileBytes = 0
+	s.blocks = nil
+	s.err = nil
+
+	return err
+}
+
+// Moves the content in memory to the temporary file once it passes the threshold
+func (s *SpillingStringBuilder) spill() {
+	if s.err != nil {
+		s.memory.Clear()
+		return
+	}
+	if s.memory.Len() <= s.threshold {
+		return
+	}
+
+	if s.file == nil {
+		file, err := os.CreateTemp(s.dir, "stringbuilder-*.txt")
+		if err != nil {
+			s.err = err
+			return
+		}
+		s.file = file
+	}
+
+	// An incomplete rune of the last Write stays in memory, so the next Write can still complete it
+	pending := append([]byte(nil), s.memory.pending.bytes[:s.memory.pending.len]...)
+	runes := s.memory.runes()[:s.memory.Len()-len(pending)]
+	if len(runes) == 0 {
+		return
+	}
+
+	s.blocks = append(s.blocks, spillBlock{s.fileRunes, s.fileBytes})
+	n, err := writeRunesTo(s.file, runes)
+	s.fileBytes += n
+	if err != nil {
+		s.err = err
+		return
+	}
+	s.fileRunes += len(runes)
+
+	s.memory.Clear()
+	s.memory.appendBytes(pending)
+}
+
+// Remembers the first error of the temporary file
+func (s *SpillingStringBuilder) setErr(err error) {
+	if s.err == nil {
+		s.err = err
+	}
+}
+
+// Calls fn for every rune from the rune offset from onwards until fn returns false
+func (s *SpillingStringBuilder) each(from int, fn func(position int, r rune) bool) error {
+	position := from
+	if from < s.fileRunes {
+		// The last block starting at or before from
+		i := sort.Search(len(s.blocks), func(i int) bool { return s.blocks[i].runeOffset > from }) - 1
+		block := s.blocks[i]
+		reader := bufio.NewReader(io.NewSectionReader(s.file, block.byteOffset, s.fileBytes-block.byteOffset))
+
+		for skip := from - block.runeOffset; skip > 0; skip-- {
+			if _, _, err := reader.ReadRune(); err != nil {
+				return err
+			}
+		}
+		for ; position < s.fileRunes; position++ {
+			r, _, err := reader.ReadRune()
+			if err != nil {
+				return err
+			}
+			if !fn(position, r) {
+				return nil
+			}
+		}
+	}
+
+	for _, r := range s.memory.runes()[position-s.fileRunes:] {
+		if !fn(position, r) {
+			return nil
+		}
+		position++
+	}
+
+	return nil
+}
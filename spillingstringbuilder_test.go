@@ -0,0 +1,201 @@
+package Text
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestSpillingStringBuilderStaysInMemoryBelowThreshold(t *testing.T) {
+	dir := t.TempDir()
+	s := NewSpillingStringBuilder(100, dir)
+
+	s.Append("Hello").AppendRune(' ').AppendLine("World")
+
+	if s.Spilled() || s.Len() != 12 {
+		t.Errorf("SpillingStringBuilder spilled = %v with length %v, want it in memory with %v", s.Spilled(), s.Len(), 12)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("SpillingStringBuilder created %v files, want none", len(entries))
+	}
+}
+
+func TestSpillingStringBuilderSpillsToFile(t *testing.T) {
+	dir := t.TempDir()
+	s := NewSpillingStringBuilder(10, dir)
+	expected := &strings.Builder{}
+
+	for i := 0; i < 100; i++ {
+		s.AppendInt(i).Append(";Hällo Wörld").AppendLine("")
+		expected.WriteString(strconv.Itoa(i) + ";Hällo Wörld\n")
+	}
+
+	if !s.Spilled() {
+		t.Fatalf("SpillingStringBuilder.Spilled() = false, want true")
+	}
+	if s.Len() != len([]rune(expected.String())) {
+		t.Errorf("SpillingStringBuilder.Len() = %v, want %v", s.Len(), len([]rune(expected.String())))
+	}
+
+	var output strings.Builder
+	if _, err := s.WriteTo(&output); err != nil {
+		t.Fatalf("SpillingStringBuilder.WriteTo() threw an error: %v", err)
+	}
+	if output.String() != expected.String() {
+		t.Errorf("SpillingStringBuilder.WriteTo() = %q, want %q", output.String(), expected.String())
+	}
+}
+
+func TestSpillingStringBuilderFindFirst(t *testing.T) {
+	s := NewSpillingStringBuilder(8, t.TempDir())
+	s.Append("abcdefgh").Append("ijkläöü").Append("Needle")
+
+	tests := []struct {
+		needle string
+		want   int
+	}{
+		{"abc", 0},
+		{"ghij", 6},
+		{"äöü", 12},
+		{"Needle", 15},
+		{"Haystack", -1},
+	}
+	for _, tt := range tests {
+		if got := s.FindFirst(tt.needle); got != tt.want {
+			t.Errorf("SpillingStringBuilder.FindFirst(%q) = %v, want %v", tt.needle, got, tt.want)
+		}
+	}
+}
+
+func TestSpillingStringBuilderFindFirstReportsReadErrors(t *testing.T) {
+	s := NewSpillingStringBuilder(4, t.TempDir())
+	s.Append("Hello World")
+	s.file.Close()
+
+	got := s.FindFirst("World")
+
+	if got != -1 {
+		t.Errorf("SpillingStringBuilder.FindFirst() = %v, want %v", got, -1)
+	}
+	if s.Err() == nil {
+		t.Errorf("SpillingStringBuilder.Err() = nil, want an error")
+	}
+}
+
+func TestSpillingStringBuilderSubstring(t *testing.T) {
+	s := NewSpillingStringBuilder(4, t.TempDir())
+	text := "Hällo Wörld, this is a Gopher"
+	for _, word := range strings.SplitAfter(text, " ") {
+		s.Append(word)
+	}
+	runes := []rune(text)
+
+	for _, bounds := range [][2]int{{0, 5}, {3, 12}, {13, 29}, {27, 29}, {5, 5}} {
+		got, err := s.Substring(bounds[0], bounds[1])
+		if err != nil {
+			t.Fatalf("SpillingStringBuilder.Substring() threw an error: %v", err)
+		}
+		if want := string(runes[bounds[0]:bounds[1]]); got != want {
+			t.Errorf("SpillingStringBuilder.Substring(%v, %v) = %q, want %q", bounds[0], bounds[1], got, want)
+		}
+	}
+
+	if _, err := s.Substring(10, 30); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("SpillingStringBuilder.Substring() = %v, want %v", err, ErrIndexOutOfRange)
+	}
+}
+
+func TestSpillingStringBuilderKeepsSplitRuneWhenSpilling(t *testing.T) {
+	s := NewSpillingStringBuilder(4, t.TempDir())
+	encoded := []byte("Grüße")
+
+	s.Write(encoded[:3])
+	s.Write(encoded[3:])
+	s.AppendFormat(" {0:N1}", 1234.5)
+
+	var output strings.Builder
+	s.WriteTo(&output)
+	if output.String() != "Grüße 1,234.5" {
+		t.Errorf("SpillingStringBuilder.WriteTo() = %q, want %q", output.String(), "Grüße 1,234.5")
+	}
+}
+
+func TestSpillingStringBuilderCompletesRuneSplitAtTheThreshold(t *testing.T) {
+	s := NewSpillingStringBuilder(4, t.TempDir())
+
+	s.Write([]byte("Hello\xe2\x82"))
+	s.Write([]byte("\xac"))
+
+	var output strings.Builder
+	s.WriteTo(&output)
+	if output.String() != "Hello\u20AC" || s.Len() != 6 {
+		t.Errorf("SpillingStringBuilder.WriteTo() = %+q with length %v, want %+q", output.String(), s.Len(), "Hello\u20AC")
+	}
+}
+
+func TestSpillingStringBuilderWriteKeepsOrderWithOtherAppends(t *testing.T) {
+	s := NewSpillingStringBuilder(4, t.TempDir())
+
+	s.Write([]byte("Hello\xe2\x82"))
+	s.Append("x")
+	s.Write([]byte("\xac"))
+	s.Write([]byte("trunc\xe2"))
+
+	var output strings.Builder
+	s.WriteTo(&output)
+	want := "Hello\uFFFD\uFFFDx\uFFFDtrunc\uFFFD"
+	if output.String() != want || s.Len() != 15 {
+		t.Errorf("SpillingStringBuilder.WriteTo() = %+q with length %v, want %+q", output.String(), s.Len(), want)
+	}
+}
+
+func TestSpillingStringBuilderKeepsInvalidRunesAcrossTheThreshold(t *testing.T) {
+	s := NewSpillingStringBuilder(4, t.TempDir())
+
+	s.Append("ab").AppendRune(0xD800).AppendRune(0x110000)
+	inMemory := s.FindFirst("\uFFFD\uFFFD")
+	s.Append("cd")
+	spilled := s.FindFirst("\uFFFD\uFFFD")
+
+	if !s.Spilled() || inMemory != 2 || spilled != 2 {
+		t.Errorf("SpillingStringBuilder.FindFirst() = %v in memory and %v spilled, want 2", inMemory, spilled)
+	}
+	if got, _ := s.Substring(0, s.Len()); got != "ab\uFFFD\uFFFDcd" {
+		t.Errorf("SpillingStringBuilder.Substring() = %+q, want %+q", got, "ab\uFFFD\uFFFDcd")
+	}
+}
+
+func TestSpillingStringBuilderCloseDeletesFile(t *testing.T) {
+	dir := t.TempDir()
+	s := NewSpillingStringBuilder(4, dir)
+	s.Append("Hello World")
+
+	if entries, _ := os.ReadDir(dir); len(entries) != 1 {
+		t.Fatalf("SpillingStringBuilder created %v files, want 1", len(entries))
+	}
+	if err := s.Close(); err != nil {
+		t.Fatalf("SpillingStringBuilder.Close() threw an error: %v", err)
+	}
+
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("SpillingStringBuilder left %v files behind, want none", len(entries))
+	}
+	if s.Len() != 0 || s.Spilled() {
+		t.Errorf("SpillingStringBuilder has length %v after Close, want 0", s.Len())
+	}
+}
+
+func TestSpillingStringBuilderReportsFileErrors(t *testing.T) {
+	s := NewSpillingStringBuilder(4, "/this/directory/does/not/exist")
+
+	s.Append("Hello World")
+
+	if s.Err() == nil {
+		t.Errorf("SpillingStringBuilder.Err() = nil, want an error")
+	}
+	if _, err := s.WriteString("!"); err == nil {
+		t.Errorf("SpillingStringBuilder.WriteString() = nil, want an error")
+	}
+}
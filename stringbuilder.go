@@ -1,41 +1,97 @@
 package Text
 
 import (
-	"fmt"
 	"strconv"
+	"unicode/utf8"
 )
 
 type StringBuilder struct {
 	data     []rune
 	position int
+	// Incomplete rune at the end of the last Write
+	pending     pendingRune
+	culture     *Culture
+	maxCapacity int
+	growth      GrowthPolicy
+	// First error of a chainable call
+	err     error
+	history *history
+	journal *journal
+	markers []*Marker
+	// Built by the first line query
+	lines *lineIndex
+	// Subscribers of OnChange
+	observers      []observer
+	nextObserverID uint64
+	// Appends are recorded as one while this is above 0
+	batching int
+	// Number of leading runes of data which are shared with a clone or snapshot and must not be overwritten
+	frozen int
 }
 
 // Creates a new instance of the StringBuilder with preallocated array
-func NewStringBuilder(initialCapacity int) *StringBuilder {
-	return &StringBuilder{data: make([]rune, initialCapacity)}
+func NewStringBuilder(initialCapacity int, opts ...Option) *StringBuilder {
+	s := &StringBuilder{}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if s.maxCapacity > 0 && initialCapacity > s.maxCapacity {
+		initialCapacity = s.maxCapacity
+	}
+	s.data = make([]rune, initialCapacity)
+
+	return s
 }
 
-// Creates a new instance of the StringBuilder with a preallocated text
-func NewStringBuilderFromString(text string) *StringBuilder {
+// Creates a new instance of the StringBuilder with a preallocated text.
+// If the text exceeds the maximum capacity the StringBuilder stays empty and Err returns ErrCapacityExceeded.
+func NewStringBuilderFromString(text string, opts ...Option) *StringBuilder {
+	s := &StringBuilder{}
+	for _, opt := range opts {
+		opt(s)
+	}
+
 	textRunes := []rune(text)
-	return &StringBuilder{
-		data:     textRunes,
-		position: len(textRunes),
+	if s.maxCapacity > 0 && len(textRunes) > s.maxCapacity {
+		s.setErr(ErrCapacityExceeded)
+		return s
 	}
+	s.data = textRunes
+	s.position = len(textRunes)
+
+	return s
 }
 
 // Appends a text to the StringBuilder instance
 func (s *StringBuilder) Append(text string) *StringBuilder {
-	s.resize(text)
-	textRunes := []rune(text)
-	copy(s.data[s.position:], textRunes)
-	s.position = s.position + len(textRunes)
+	if err := s.appendString(text); err != nil {
+		s.setErr(err)
+	}
 
 	return s
 }
 
+func (s *StringBuilder) appendString(text string) error {
+	if err := s.reserveString(text); err != nil {
+		return err
+	}
+	s.unshare(s.position)
+	s.dropPending()
+	from := s.position
+	for _, r := range text {
+		s.data[s.position] = r
+		s.position++
+	}
+	s.recordAppend(from)
+
+	return nil
+}
+
 // Appends a text and a new line character to the StringBuilder instance
 func (s *StringBuilder) AppendLine(text string) *StringBuilder {
+	from := s.beginAppend()
+	defer s.endAppend(from)
+
 	s.Append(text)
 	s.Append("\n")
 
@@ -44,14 +100,24 @@ func (s *StringBuilder) AppendLine(text string) *StringBuilder {
 
 // Appends a single character to the StringBuilder instance
 func (s *StringBuilder) AppendRune(char rune) *StringBuilder {
-	newLen := s.position + 1
-	if newLen >= cap(s.data) {
-		s.grow(newLen)
+	if err := s.appendRune(char); err != nil {
+		s.setErr(err)
 	}
+
+	return s
+}
+
+func (s *StringBuilder) appendRune(char rune) error {
+	if err := s.reserve(1); err != nil {
+		return err
+	}
+	s.unshare(s.position)
+	s.dropPending()
 	s.data[s.position] = char
 	s.position++
+	s.recordAppend(s.position - 1)
 
-	return s
+	return nil
 }
 
 // Appends a single integer to the StringBuilder instance
@@ -64,24 +130,33 @@ func (s *StringBuilder) AppendBool(flag bool) *StringBuilder {
 	return s.Append(strconv.FormatBool(flag))
 }
 
-// Appends a list of strings to the StringBuilder instance
+// Appends a list of strings to the StringBuilder instance. Either all or none of the words get appended.
 func (s *StringBuilder) AppendList(words []string) *StringBuilder {
-	s.resize(words...)
-	for _, word := range words {
-		s = s.Append(word)
-	}
-	return s
-}
-
-func (s *StringBuilder) resize(words ...string) {
 	allWordLength := 0
 	for _, word := range words {
 		allWordLength += len(word)
 	}
-	newLen := s.position + allWordLength
-	if newLen > cap(s.data) {
-		s.grow(newLen)
+	err := s.reserve(allWordLength)
+	if err != nil && s.maxCapacity > 0 {
+		// The byte length doesn't fit, but the runes still might
+		runes := 0
+		for _, word := range words {
+			runes += utf8.RuneCountInString(word)
+		}
+		err = s.reserve(runes)
+	}
+	if err != nil {
+		s.setErr(err)
+		return s
 	}
+
+	from := s.beginAppend()
+	for _, word := range words {
+		s.appendString(word)
+	}
+	s.endAppend(from)
+
+	return s
 }
 
 // Returns the current length of the represented string
@@ -94,21 +169,10 @@ func (s *StringBuilder) ToString() string {
 	return string(s.data[:s.position])
 }
 
+// Removes length runes starting at start
 func (s *StringBuilder) Remove(start int, length int) error {
-	if start >= s.position {
-		return fmt.Errorf("start is after the end of the string")
-	}
-	if start < 0 {
-		return fmt.Errorf("start can't be a negative value")
-	}
-	if length < 0 {
-		return fmt.Errorf("length can't be a negative value")
-	}
-
-	endIndex := start + length - 1
-
-	if endIndex > s.position {
-		return fmt.Errorf("can't delete after the end of the string")
+	if err := checkRange("Remove", start, length, s.position); err != nil {
+		return err
 	}
 
 	if length == 0 {
@@ -116,29 +180,33 @@ func (s *StringBuilder) Remove(start int, length int) error {
 	}
 
 	x := start + length
+	removed := s.captureRemoved(start, x)
+	s.unshare(start)
+	s.dropPending()
 	copy(s.data[start:], s.data[x:])
 	s.position -= length
+	s.recordRemoved(ChangeRemove, start, x, removed)
 
 	return nil
 }
 
+// Inserts the text at the given index
 func (s *StringBuilder) Insert(index int, text string) error {
-	if index < 0 {
-		return fmt.Errorf("index can't be negative")
-	}
-
-	if index > s.position {
-		return fmt.Errorf("can't write outside the buffer")
+	if err := checkIndex("Insert", index, s.position, true); err != nil {
+		return err
 	}
 
 	runeText := []rune(text)
-	newLen := s.position + len(runeText)
-	if newLen >= cap(s.data) {
-		s.grow(newLen)
+	if err := s.reserve(len(runeText)); err != nil {
+		return err
 	}
+	s.unshare(index)
+	s.dropPending()
 
-	s.data = append(s.data[:index], append(runeText, s.data[index:]...)...)
-	s.position = newLen
+	copy(s.data[index+len(runeText):], s.data[index:s.position])
+	copy(s.data[index:], runeText)
+	s.position += len(runeText)
+	s.record(edit{kind: ChangeInsert, start: index, inserted: runeText})
 
 	return nil
 }
@@ -146,94 +214,152 @@ func (s *StringBuilder) Insert(index int, text string) error {
 // Removes all characters from the current instance. This sets the internal size to 0.
 // The internal array will stay the same.
 func (s *StringBuilder) Clear() {
+	end := s.position
+	removed := s.captureRemoved(0, end)
 	s.position = 0
+	s.dropPending()
+	s.err = nil
+	s.recordRemoved(ChangeClear, 0, end, removed)
 }
 
-// Gets the rune at the specific position
+// Gets the rune at the specific position. Panics with a *RangeError if the index is out of range.
 func (s *StringBuilder) RuneAt(index int) rune {
-	return s.data[index]
+	r, err := s.TryRuneAt(index)
+	if err != nil {
+		panic(err)
+	}
+
+	return r
+}
+
+// Gets the rune at the specific position or returns a *RangeError if the index is out of range
+func (s *StringBuilder) TryRuneAt(index int) (rune, error) {
+	if err := checkIndex("RuneAt", index, s.position, false); err != nil {
+		return 0, err
+	}
+
+	return s.data[index], nil
 }
 
 // Returns the string builder as a rune-slice. Be careful as this returns the internal slice.
-// Changes to that will reflect in this string builder instance.
+// Changes to that will reflect in this string builder instance. The line index is dropped, as it can't see them.
 func (s *StringBuilder) AsRuneSlice() []rune {
+	s.unshare(0)
+	s.lines = nil
+
+	return s.runes()
+}
+
+func (s *StringBuilder) runes() []rune {
 	return s.data[:s.position]
 }
 
+// Returns the search options with the culture of the StringBuilder
+func (s *StringBuilder) searchOptions(opts []SearchOption) searchOptions {
+	o := newSearchOptions(opts)
+	o.culture = s.Culture()
+
+	return o
+}
+
 // Returns the first occurrence of the given text in the string builder. Returns -1 if not found
-func (s *StringBuilder) FindFirst(text string) int {
-	return findFirst(s.AsRuneSlice(), text)
+func (s *StringBuilder) FindFirst(text string, opts ...SearchOption) int {
+	return findFirst(s.runes(), text, s.searchOptions(opts))
 }
 
 // Returns the last occurrence of the given text in the string builder. Returns -1 if not found
-func (s *StringBuilder) FindLast(text string) int {
-	return findLast(s.AsRuneSlice(), text)
+func (s *StringBuilder) FindLast(text string, opts ...SearchOption) int {
+	return findLast(s.runes(), text, s.searchOptions(opts))
 }
 
 // Returns all occurrences of the given text in the string builder. Returns an empty if no occurrence found.
-func (s *StringBuilder) FindAll(text string) []int {
-	return findAll(s.AsRuneSlice(), text)
+// Occurrences which overlap each other are reported unless the NonOverlapping option is given.
+func (s *StringBuilder) FindAll(text string, opts ...SearchOption) []int {
+	return findAll(s.runes(), text, s.searchOptions(opts))
+}
+
+// Replaces all occurrences of oldValue with newValue. With WithComparison the runes are compared
+// by their simple case folding.
+func (s *StringBuilder) ReplaceRune(oldValue rune, newValue rune, opts ...SearchOption) *StringBuilder {
+	s.beginEdit()
+	defer s.endEdit()
+
+	o := s.searchOptions(opts)
+	for i, r := range s.data[:s.position] {
+		if o.equalRunes(r, oldValue) {
+			s.unshare(i)
+			s.dropPending()
+			s.data[i] = newValue
+			if s.tracking() {
+				s.record(edit{kind: ChangeReplaceRune, start: i, removed: []rune{r}, inserted: []rune{newValue}})
+			}
+		}
+	}
+
+	return s
 }
 
 // Replaces all occurrences of oldValue with newValue
-func (s *StringBuilder) ReplaceRune(oldValue rune, newValue rune) *StringBuilder {
-	occurrences := s.FindAll(string(oldValue))
+func (s *StringBuilder) Replace(oldValue string, newValue string, opts ...SearchOption) *StringBuilder {
+	return s.ReplaceN(oldValue, newValue, -1, opts...)
+}
 
-	for _, v := range occurrences {
-		s.data[v] = newValue
+// Replaces the first occurrence of oldValue with newValue
+func (s *StringBuilder) ReplaceFirst(oldValue string, newValue string, opts ...SearchOption) *StringBuilder {
+	return s.ReplaceN(oldValue, newValue, 1, opts...)
+}
+
+// Replaces the last occurrence of oldValue with newValue
+func (s *StringBuilder) ReplaceLast(oldValue string, newValue string, opts ...SearchOption) *StringBuilder {
+	o := s.searchOptions(opts)
+	if oldValue == newValue && !o.transforming() {
+		return s
+	}
+
+	last := findLastOccurrence(s.runes(), oldValue, o)
+	if last.start == -1 {
+		return s
+	}
+
+	if err := s.replaceRanges([]replacement{{last.start, last.end, []rune(newValue)}}, ChangeReplace); err != nil {
+		s.setErr(err)
 	}
 
 	return s
 }
 
-// Replaces all occurrences of oldValue with newValue
-func (s *StringBuilder) Replace(oldValue string, newValue string) *StringBuilder {
-	if oldValue == newValue {
+// Replaces the first n occurrences of oldValue with newValue. If n is negative all occurrences get replaced.
+// Occurrences never overlap.
+func (s *StringBuilder) ReplaceN(oldValue string, newValue string, n int, opts ...SearchOption) *StringBuilder {
+	o := s.searchOptions(opts)
+	if oldValue == newValue && !o.transforming() || n == 0 {
 		return s
 	}
 
-	occurrences := s.FindAll(oldValue)
+	o.overlapping = false
+	occurrences := findOccurrences(s.runes(), oldValue, o, n)
+	if len(occurrences) == 0 {
+		return s
+	}
 
-	oldValueRunes := []rune(oldValue)
 	newValueRunes := []rune(newValue)
+	replacements := make([]replacement, len(occurrences))
+	for i, occurrence := range occurrences {
+		replacements[i] = replacement{occurrence.start, occurrence.end, newValueRunes}
+	}
 
-	delta := len(newValueRunes) - len(oldValueRunes)
-
-	for i := 0; i < len(occurrences); i++ {
-		index := occurrences[i] + delta*i
-
-		// newValue is smaller than old value
-		// We can insert the slice and remove the overhead
-		if delta < 0 {
-			copy(s.data[index:], newValueRunes)
-			s.Remove(index+len(newValueRunes), -delta)
-		} else if delta == 0 {
-			// Same length -> We can just replace the memory slice
-			copy(s.data[index:], newValueRunes)
-		} else {
-			// newValue is larger than the old value
-			// First add until the old memory region
-			// and insert afterwards the rest
-			oldLen := len(oldValueRunes)
-			copy(s.data[index:], []rune(newValueRunes[:oldLen]))
-			s.Insert(index+oldLen, string(newValueRunes[len(oldValueRunes):]))
-		}
+	if err := s.replaceRanges(replacements, ChangeReplace); err != nil {
+		s.setErr(err)
 	}
 
 	return s
 }
 
-// Implements the io.Writer interface so the StringBuilder can be used with fmt.Printf
-func (s *StringBuilder) Write(p []byte) (int, error) {
-	before := s.Len()
-	s.Append(string(p))
-	delta := s.Len() - before
-
-	return delta, nil
-}
-
 // Trims the given characters from the start and end of the string builder or all whitespaces if no characters are given
 func (s *StringBuilder) Trim(chars ...rune) *StringBuilder {
+	s.beginEdit()
+	defer s.endEdit()
+
 	return s.TrimStart(chars...).TrimEnd(chars...)
 }
 
@@ -250,8 +376,12 @@ func (s *StringBuilder) TrimStart(chars ...rune) *StringBuilder {
 	}
 
 	if start > 0 {
+		removed := s.captureRemoved(0, start)
+		s.unshare(0)
+		s.dropPending()
 		copy(s.data, s.data[start:s.position])
 		s.position -= start
+		s.recordRemoved(ChangeTrim, 0, start, removed)
 	}
 
 	return s
@@ -269,57 +399,57 @@ func (s *StringBuilder) TrimEnd(chars ...rune) *StringBuilder {
 		end--
 	}
 
+	position := s.position
+	if end < position {
+		s.dropPending()
+	}
+	removed := s.captureRemoved(end, position)
 	s.position = end
+	s.recordRemoved(ChangeTrim, end, position, removed)
 
 	return s
 }
 
 // Returns the internal array of the string builder. Be careful as this returns the internal slice.
-// Changes to that will reflect in this string builder instance.
+// Changes to that will reflect in this string builder instance. The line index is dropped, as it can't see them.
 func (s *StringBuilder) AsRuneArray() []rune {
+	s.unshare(0)
+	s.dropPending()
+	s.lines = nil
+
 	return s.data
 }
 
-// Reverses the characters of a string builder
+// Reverses the characters of a string builder. Use ReverseGraphemes to keep combining marks and emoji intact.
 func (s *StringBuilder) Reverse() *StringBuilder {
-	for left, right := 0, s.position-1; left < right; left, right = left+1, right-1 {
-		s.data[left], s.data[right] = s.data[right], s.data[left]
+	s.reverse()
+	if s.position > 1 {
+		s.record(edit{kind: ChangeReverse, reversed: true})
 	}
 
 	return s
 }
 
-// Returns a substring from start (inclusive) to end (exclusive).
-func (s *StringBuilder) Substring(start, end int) (string, error) {
-	if start < 0 {
-		return "", fmt.Errorf("start should always be greater than or equal to zero")
+func (s *StringBuilder) reverse() {
+	if s.position > 1 {
+		s.unshare(0)
+		s.dropPending()
 	}
-	if end > s.position {
-		return "", fmt.Errorf("end cannot be greater than the length of string builder")
+	for left, right := 0, s.position-1; left < right; left, right = left+1, right-1 {
+		s.data[left], s.data[right] = s.data[right], s.data[left]
 	}
-	if start > end {
-		return "", fmt.Errorf("start cannot be greater than the end for Substring() function")
+}
+
+// Returns a substring from start (inclusive) to end (exclusive).
+func (s *StringBuilder) Substring(start, end int) (string, error) {
+	if err := checkBounds("Substring", start, end, s.position); err != nil {
+		return "", err
 	}
 	r := make([]rune, end-start)
 	copy(r, s.data[start:end])
 	return string(r), nil
 }
 
-func (s *StringBuilder) grow(lenToAdd int) {
-	// Grow times 2 until lenToAdd fits
-	newLen := len(s.data)
-
-	if newLen == 0 {
-		newLen = 8
-	}
-
-	for newLen < lenToAdd {
-		newLen = newLen * 2
-	}
-
-	s.data = append(s.data, make([]rune, newLen-len(s.data))...)
-}
-
 func createTrimSet(chars ...rune) map[rune]bool {
 	trimSet := make(map[rune]bool)
 
@@ -339,13 +469,16 @@ func createTrimSet(chars ...rune) map[rune]bool {
 
 // Sets the rune at the specific position
 func (s *StringBuilder) SetRuneAt(index int, val rune) error {
-	if index < 0 {
-		return fmt.Errorf("index should always be greater than or equal to zero")
-	}
-	if index > s.position {
-		return fmt.Errorf("index cannot be greater than current position")
+	if err := checkIndex("SetRuneAt", index, s.position, false); err != nil {
+		return err
 	}
+	old := s.data[index]
+	s.unshare(index)
+	s.dropPending()
 	s.data[index] = val
+	if s.tracking() {
+		s.record(edit{kind: ChangeSetRune, start: index, removed: []rune{old}, inserted: []rune{val}})
+	}
 
 	return nil
 }
@@ -18,6 +18,14 @@ func BenchmarkStringBuilderConcat(b *testing.B) {
 	result = r
 }
 
+func BenchmarkUtf8StringBuilderConcat(b *testing.B) {
+	var r string
+	for n := 0; n < b.N; n++ {
+		r = benchmarkUtf8StringBuilderConcat(text, count)
+	}
+	result = r
+}
+
 func BenchmarkStringConcat(b *testing.B) {
 	var r string
 	for n := 0; n < b.N; n++ {
@@ -34,6 +42,30 @@ func BenchmarkGoStringBuilderConcat(b *testing.B) {
 	result = r
 }
 
+func BenchmarkReplaceWithLongerValue(b *testing.B) {
+	benchmarkReplace(b, "ab", "abc")
+}
+
+func BenchmarkReplaceWithShorterValue(b *testing.B) {
+	benchmarkReplace(b, "ab", "a")
+}
+
+func BenchmarkReplaceWithSameLength(b *testing.B) {
+	benchmarkReplace(b, "ab", "ba")
+}
+
+// Replaces 10.000 occurrences in a text of 50.000 runes
+func benchmarkReplace(b *testing.B, oldValue, newValue string) {
+	input := strings.Repeat("ab-cd", 10000)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		s := NewStringBuilderFromString(input)
+		s.Replace(oldValue, newValue)
+		result = s.ToString()
+	}
+}
+
 func benchmarkStringBuilderConcat(text string, count int) string {
 	s := NewStringBuilder(64)
 	for i := 0; i < count; i++ {
@@ -43,6 +75,15 @@ func benchmarkStringBuilderConcat(text string, count int) string {
 	return s.ToString()
 }
 
+func benchmarkUtf8StringBuilderConcat(text string, count int) string {
+	s := NewUtf8StringBuilder(64)
+	for i := 0; i < count; i++ {
+		s.Append(text)
+	}
+
+	return s.ToString()
+}
+
 func benchmarkStringConcat(text string, count int) string {
 	s := ""
 	for i := 0; i < count; i++ {
@@ -1,8 +1,10 @@
 package Text
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -309,6 +311,7 @@ func TestFindAll(t *testing.T) {
 		{"Needle longer than haystack", "a", "ab", []int{}},
 		{"Hello in Hello World", "Hello World", "Hello", []int{0}},
 		{"ö in Helöö", "Hellöö", "ö", []int{4, 5}},
+		{"Partial match at the end", "aaaa", "aa", []int{0, 1, 2}},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -344,6 +347,11 @@ func TestReplace(t *testing.T) {
 		{"Replace Hello with Hallöchen", "Hello World", "Hello", "Hallöchen", "Hallöchen World"},
 		{"Replace ö with ä", "äö", "ö", "ä", "ää"},
 		{"Replace with same word", "Hello", "llo", "llo", "Hello"},
+		{"Replace multiple with longer word", "a-a-a", "a", "äbc", "äbc-äbc-äbc"},
+		{"Replace multiple with shorter word", "abc-abc-abc", "abc", "x", "x-x-x"},
+		{"Replace overlapping occurrences", "aaaaa", "aa", "b", "bba"},
+		{"Replace with empty word", "Hello World", "l", "", "Heo Word"},
+		{"Replace not found", "Hello", "x", "y", "Hello"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -358,6 +366,44 @@ func TestReplace(t *testing.T) {
 	}
 }
 
+func TestReplaceFirstLastAndN(t *testing.T) {
+	tests := []struct {
+		name    string
+		replace func(s *StringBuilder) *StringBuilder
+		want    string
+	}{
+		{"ReplaceFirst", func(s *StringBuilder) *StringBuilder { return s.ReplaceFirst("ab", "xyz") }, "xyz-ab-ab-ab"},
+		{"ReplaceLast", func(s *StringBuilder) *StringBuilder { return s.ReplaceLast("ab", "xyz") }, "ab-ab-ab-xyz"},
+		{"ReplaceN with 2", func(s *StringBuilder) *StringBuilder { return s.ReplaceN("ab", "x", 2) }, "x-x-ab-ab"},
+		{"ReplaceN with more than occurrences", func(s *StringBuilder) *StringBuilder { return s.ReplaceN("ab", "x", 10) }, "x-x-x-x"},
+		{"ReplaceN with 0", func(s *StringBuilder) *StringBuilder { return s.ReplaceN("ab", "x", 0) }, "ab-ab-ab-ab"},
+		{"ReplaceN with negative count", func(s *StringBuilder) *StringBuilder { return s.ReplaceN("ab", "x", -1) }, "x-x-x-x"},
+		{"ReplaceLast not found", func(s *StringBuilder) *StringBuilder { return s.ReplaceLast("c", "x") }, "ab-ab-ab-ab"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewStringBuilderFromString("ab-ab-ab-ab")
+
+			if got := tt.replace(s).ToString(); got != tt.want {
+				t.Errorf("StringBuilder.Replace() = %v, want %v", got, tt.want)
+			}
+			if got := s.Len(); got != len([]rune(tt.want)) {
+				t.Errorf("StringBuilder.Len() = %v, want %v", got, len([]rune(tt.want)))
+			}
+		})
+	}
+}
+
+func TestReplaceGrowsBufferOnce(t *testing.T) {
+	s := NewStringBuilderFromString(strings.Repeat("a.", 1000))
+
+	s.Replace(".", "...").Append("!")
+
+	if got, want := s.ToString(), strings.Repeat("a...", 1000)+"!"; got != want {
+		t.Errorf("StringBuilder.Replace() = %v, want %v", got, want)
+	}
+}
+
 func TestWrite(t *testing.T) {
 	const want = "3...2...1..."
 	s := &StringBuilder{}
@@ -448,30 +494,27 @@ func TestReuseReversedStringBuilder(t *testing.T) {
 
 func TestStringBuilderSubstring(t *testing.T) {
 	tests := []struct {
-		name         string
-		start        int
-		end          int
-		substring    string
-		errorMessage string
+		name      string
+		start     int
+		end       int
+		substring string
+		wantErr   error
 	}{
-		{"Substring with negative start", -1, 3, "", "start should always be greater than or equal to zero"},
-		{"Substring with end exceeding string builder length", 0, 5, "", "end cannot be greater than the length of string builder"},
-		{"Substring with start greater than end", 3, 2, "", "start cannot be greater than the end for Substring() function"},
-		{"Substring with start equal to zero", 0, 3, "abc", ""},
-		{"Substring with end equal to length of string builder", 0, 4, "abcd", ""},
-		{"Substring of length 1", 0, 1, "a", ""},
-		{"Substring of length 0", 0, 0, "", ""},
-		{"Substring in middle of string builder", 1, 3, "bc", ""},
+		{"Substring with negative start", -1, 3, "", ErrNegativeIndex},
+		{"Substring with end exceeding string builder length", 0, 5, "", ErrIndexOutOfRange},
+		{"Substring with start greater than end", 3, 2, "", ErrInvalidRange},
+		{"Substring with start equal to zero", 0, 3, "abc", nil},
+		{"Substring with end equal to length of string builder", 0, 4, "abcd", nil},
+		{"Substring of length 1", 0, 1, "a", nil},
+		{"Substring of length 0", 0, 0, "", nil},
+		{"Substring in middle of string builder", 1, 3, "bc", nil},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			sb := NewStringBuilderFromString("abcd")
 			s, err := sb.Substring(tt.start, tt.end)
-			if err != nil {
-				if err.Error() != tt.errorMessage {
-					t.Errorf("StringBuilder.Substring() expected error message = %v, got = %v", tt.errorMessage, err.Error())
-				}
-
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("StringBuilder.Substring() expected error = %v, got = %v", tt.wantErr, err)
 			}
 			if s != tt.substring {
 				t.Errorf("StringBuilder.Substring() expected substring = %v, got = %v", tt.substring, s)
@@ -0,0 +1,240 @@
+package Text
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	roundTripTimePattern = "yyyy'-'MM'-'dd'T'HH':'mm':'ss'.'fffffffK"
+	rfc1123TimePattern   = "ddd, dd MMM yyyy HH':'mm':'ss 'GMT'"
+	sortableTimePattern  = "yyyy'-'MM'-'dd'T'HH':'mm':'ss"
+	universalTimePattern = "yyyy'-'MM'-'dd HH':'mm':'ss'Z'"
+)
+
+// Appends t formatted with a .NET standard or custom date and time format
+func appendFormattedTime(dst []byte, t time.Time, format string, c *Culture) ([]byte, error) {
+	if len(format) > 1 {
+		return appendCustomTime(dst, t, format, c)
+	}
+
+	specifier := byte('G')
+	if len(format) == 1 {
+		specifier = format[0]
+	}
+
+	switch specifier {
+	case 'd':
+		return appendCustomTime(dst, t, c.ShortDatePattern, c)
+	case 'D':
+		return appendCustomTime(dst, t, c.LongDatePattern, c)
+	case 'f', 'F', 'g', 'G', 'U':
+		datePattern, timePattern := c.LongDatePattern, c.LongTimePattern
+		if specifier == 'g' || specifier == 'G' {
+			datePattern = c.ShortDatePattern
+		}
+		if specifier == 'f' || specifier == 'g' {
+			timePattern = c.ShortTimePattern
+		}
+		if specifier == 'U' {
+			t = t.UTC()
+		}
+		dst, err := appendCustomTime(dst, t, datePattern, c)
+		if err != nil {
+			return dst, err
+		}
+		return appendCustomTime(append(dst, ' '), t, timePattern, c)
+	case 'M', 'm':
+		return appendCustomTime(dst, t, c.MonthDayPattern, c)
+	case 'O', 'o':
+		return appendCustomTime(dst, t, roundTripTimePattern, InvariantCulture)
+	case 'R', 'r':
+		return appendCustomTime(dst, t.UTC(), rfc1123TimePattern, InvariantCulture)
+	case 's':
+		return appendCustomTime(dst, t, sortableTimePattern, InvariantCulture)
+	case 't':
+		return appendCustomTime(dst, t, c.ShortTimePattern, c)
+	case 'T':
+		return appendCustomTime(dst, t, c.LongTimePattern, c)
+	case 'u':
+		return appendCustomTime(dst, t.UTC(), universalTimePattern, InvariantCulture)
+	case 'Y', 'y':
+		return appendCustomTime(dst, t, c.YearMonthPattern, c)
+	}
+
+	return dst, fmt.Errorf("format specifier %q is not supported for dates", format)
+}
+
+// Appends t formatted with a .NET custom date and time format like "dd.MM.yyyy HH:mm"
+func appendCustomTime(dst []byte, t time.Time, format string, c *Culture) ([]byte, error) {
+	for i := 0; i < len(format); i++ {
+		ch := format[i]
+		count := 1
+		for i+count < len(format) && format[i+count] == ch {
+			count++
+		}
+
+		switch ch {
+		case 'd':
+			switch count {
+			case 1, 2:
+				dst = appendPadded(dst, t.Day(), count)
+			case 3:
+				dst = append(dst, c.AbbreviatedDayNames[t.Weekday()]...)
+			default:
+				dst = append(dst, c.DayNames[t.Weekday()]...)
+			}
+		case 'M':
+			switch count {
+			case 1, 2:
+				dst = appendPadded(dst, int(t.Month()), count)
+			case 3:
+				dst = append(dst, c.AbbreviatedMonthNames[t.Month()-1]...)
+			default:
+				dst = append(dst, c.MonthNames[t.Month()-1]...)
+			}
+		case 'y':
+			if count <= 2 {
+				dst = appendPadded(dst, t.Year()%100, count)
+			} else {
+				dst = appendPadded(dst, t.Year(), count)
+			}
+		case 'h':
+			hour := t.Hour() % 12
+			if hour == 0 {
+				hour = 12
+			}
+			dst = appendPadded(dst, hour, minInt(count, 2))
+		case 'H':
+			dst = appendPadded(dst, t.Hour(), minInt(count, 2))
+		case 'm':
+			dst = appendPadded(dst, t.Minute(), minInt(count, 2))
+		case 's':
+			dst = appendPadded(dst, t.Second(), minInt(count, 2))
+		case 'f', 'F':
+			if count > 7 {
+				return dst, fmt.Errorf("format %q has more than 7 fraction digits", format)
+			}
+			fraction := t.Nanosecond()
+			for j := count; j < 9; j++ {
+				fraction /= 10
+			}
+			if ch == 'f' {
+				dst = appendPadded(dst, fraction, count)
+				break
+			}
+			if fraction == 0 {
+				// Like .NET, a zero fraction also drops the decimal point in front of it
+				if len(dst) > 0 && dst[len(dst)-1] == '.' {
+					dst = dst[:len(dst)-1]
+				}
+				break
+			}
+			// Trims into its own width, count has to stay the length of the token
+			width := count
+			for fraction%10 == 0 {
+				fraction /= 10
+				width--
+			}
+			dst = appendPadded(dst, fraction, width)
+		case 't':
+			designator := c.AMDesignator
+			if t.Hour() >= 12 {
+				designator = c.PMDesignator
+			}
+			if count == 1 && designator != "" {
+				_, size := utf8.DecodeRuneInString(designator)
+				designator = designator[:size]
+			}
+			dst = append(dst, designator...)
+		case 'g':
+			dst = append(dst, "A.D."...)
+		case 'K':
+			if t.Location() == time.UTC {
+				dst = append(dst, 'Z')
+			} else {
+				dst = appendTimeZoneOffset(dst, t, 3)
+			}
+		case 'z':
+			dst = appendTimeZoneOffset(dst, t, count)
+		case ':':
+			dst = append(dst, c.TimeSeparator...)
+			count = 1
+		case '/':
+			dst = append(dst, c.DateSeparator...)
+			count = 1
+		case '\'', '"':
+			j := i + 1
+			for ; j < len(format) && format[j] != ch; j++ {
+				if format[j] == '\\' && j+1 < len(format) {
+					j++
+				}
+				dst = append(dst, format[j])
+			}
+			if j >= len(format) {
+				return dst, fmt.Errorf("format %q has an unterminated quote", format)
+			}
+			count = j - i + 1
+		case '%':
+			// Marks a single character custom format like "%d"
+			count = 1
+		case '\\':
+			if i+1 >= len(format) {
+				return dst, fmt.Errorf("format %q ends with an escape character", format)
+			}
+			dst = append(dst, format[i+1])
+			count = 2
+		default:
+			dst = append(dst, ch)
+			count = 1
+		}
+
+		i += count - 1
+	}
+
+	return dst, nil
+}
+
+// Appends the offset to UTC as +h, +hh or +hh:mm depending on count
+func appendTimeZoneOffset(dst []byte, t time.Time, count int) []byte {
+	_, offset := t.Zone()
+	if offset < 0 {
+		dst = append(dst, '-')
+		offset = -offset
+	} else {
+		dst = append(dst, '+')
+	}
+
+	hours, minutes := offset/3600, offset%3600/60
+	if count == 1 {
+		return strconv.AppendInt(dst, int64(hours), 10)
+	}
+	dst = appendPadded(dst, hours, 2)
+	if count >= 3 {
+		dst = append(dst, ':')
+		dst = appendPadded(dst, minutes, 2)
+	}
+
+	return dst
+}
+
+// Appends the non-negative value with at least width digits
+func appendPadded(dst []byte, value int, width int) []byte {
+	var buffer [20]byte
+	digits := strconv.AppendInt(buffer[:0], int64(value), 10)
+	for i := len(digits); i < width; i++ {
+		dst = append(dst, '0')
+	}
+
+	return append(dst, digits...)
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
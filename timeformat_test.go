@@ -0,0 +1,69 @@
+package Text
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAppendFormattedTime(t *testing.T) {
+	value := time.Date(2024, 3, 5, 14, 7, 9, 123456789, time.UTC)
+	berlin := time.Date(2024, 3, 5, 9, 5, 0, 0, time.FixedZone("CEST", 2*60*60))
+	millis := time.Date(2024, 3, 5, 14, 7, 9, 120000000, time.UTC)
+
+	tests := []struct {
+		name    string
+		culture *Culture
+		value   time.Time
+		format  string
+		want    string
+	}{
+		{"Invariant short date", InvariantCulture, value, "d", "03/05/2024"},
+		{"en-US short date", CultureEnUS, value, "d", "3/5/2024"},
+		{"de-DE short date", CultureDeDE, value, "d", "05.03.2024"},
+		{"en-US long date", CultureEnUS, value, "D", "Tuesday, March 5, 2024"},
+		{"de-DE long date", CultureDeDE, value, "D", "Dienstag, 5. März 2024"},
+		{"tr-TR long date", CultureTrTR, value, "D", "5 Mart 2024 Salı"},
+		{"en-US general", CultureEnUS, value, "g", "3/5/2024 2:07 PM"},
+		{"Default is general", InvariantCulture, value, "", "03/05/2024 14:07:09"},
+		{"de-DE full", CultureDeDE, value, "F", "Dienstag, 5. März 2024 14:07:09"},
+		{"Round trip", CultureDeDE, value, "O", "2024-03-05T14:07:09.1234567Z"},
+		{"Round trip with offset", InvariantCulture, berlin, "o", "2024-03-05T09:05:00.0000000+02:00"},
+		{"RFC1123", CultureDeDE, berlin, "R", "Tue, 05 Mar 2024 07:05:00 GMT"},
+		{"Sortable", InvariantCulture, value, "s", "2024-03-05T14:07:09"},
+		{"Universal sortable", InvariantCulture, berlin, "u", "2024-03-05 07:05:00Z"},
+		{"Year month", CultureEnUS, value, "Y", "March 2024"},
+		{"Custom with milliseconds", InvariantCulture, value, "yyyy-MM-dd HH:mm:ss.fff", "2024-03-05 14:07:09.123"},
+		{"Twelve hour clock", InvariantCulture, value, "h:mm tt", "2:07 PM"},
+		{"Short designator", InvariantCulture, berlin, "hh:mm t", "09:05 A"},
+		{"Zero fraction", InvariantCulture, berlin, "HH:mm:ss.FFF", "09:05:00"},
+		{"Trimmed fraction", InvariantCulture, value, "ss.FFFFFFF", "09.1234567"},
+		{"Single digit fraction", InvariantCulture, millis, "ss.F", "09.1"},
+		{"Fraction with trailing zeros", InvariantCulture, millis, "ss.FFF", "09.12"},
+		{"Seven digit fraction with trailing zeros", InvariantCulture, millis, "HH:mm:ss.FFFFFFF", "14:07:09.12"},
+		{"Offset", InvariantCulture, berlin, "z zz zzz", "+2 +02 +02:00"},
+		{"Culture separators", CultureDeDE, value, "dd/MM/yy", "05.03.24"},
+		{"Quoted literal", InvariantCulture, value, "'Day' d", "Day 5"},
+		{"Escaped character", InvariantCulture, value, `d\d`, "5d"},
+		{"Single custom specifier", InvariantCulture, value, "%d", "5"},
+		{"Abbreviated names", CultureDeDE, value, "ddd, d. MMM", "Di, 5. Mrz"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := appendFormattedTime(nil, tt.value, tt.format, tt.culture)
+			if err != nil {
+				t.Fatalf("appendFormattedTime() threw an error: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("appendFormattedTime(%q) = %q, want %q", tt.format, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAppendFormattedTimeErrors(t *testing.T) {
+	for _, format := range []string{"Q", "ffffffff", "'unterminated", `dd\`} {
+		if _, err := appendFormattedTime(nil, time.Now(), format, InvariantCulture); err == nil {
+			t.Errorf("appendFormattedTime(%q) should return an error", format)
+		}
+	}
+}
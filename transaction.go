@@ -0,0 +1,240 @@
+package Text
+
+import "sync"
+
+// Transaction groups edits of a StringBuilder which can be committed or rolled back together.
+// Transactions can be nested: committing an inner transaction hands its edits to the outer one,
+// so the outer transaction can still roll them back.
+type Transaction struct {
+	builder    *StringBuilder
+	start      checkpoint
+	savepoints []savepoint
+	done       bool
+	// Set for transactions of a ConcurrentStringBuilder
+	locker sync.Locker
+}
+
+type savepoint struct {
+	name string
+	checkpoint
+}
+
+// The state of a StringBuilder a transaction can go back to
+type checkpoint struct {
+	// Number of edits in the journal at that time
+	edits   int
+	length  int
+	pending pendingRune
+	err     error
+}
+
+// Logs the edits of all open transactions of a StringBuilder. Appends are not logged,
+// as rolling them back is just cutting the text back to its old length.
+type journal struct {
+	edits       []edit
+	open        []*Transaction
+	rollingBack bool
+}
+
+// Starts a transaction. All following edits can be undone with Rollback until the transaction is committed.
+// If a transaction is already open, the new one is nested into it.
+func (s *StringBuilder) Begin() *Transaction {
+	if s.journal == nil {
+		s.journal = &journal{}
+	}
+
+	tx := &Transaction{builder: s, start: s.checkpoint()}
+	s.journal.open = append(s.journal.open, tx)
+
+	return tx
+}
+
+// Keeps all edits of the transaction. Transactions nested into it are committed as well.
+// Returns ErrTransactionClosed if the transaction was already committed or rolled back.
+func (tx *Transaction) Commit() error {
+	tx.lock()
+	defer tx.unlock()
+
+	if tx.done {
+		return ErrTransactionClosed
+	}
+
+	tx.builder.closeTransaction(tx)
+
+	return nil
+}
+
+// Restores the exact content the StringBuilder had when the transaction began and closes the transaction.
+// Transactions nested into it are rolled back as well.
+// Returns ErrTransactionClosed if the transaction was already committed or rolled back.
+func (tx *Transaction) Rollback() error {
+	tx.lock()
+	defer tx.unlock()
+
+	if tx.done {
+		return ErrTransactionClosed
+	}
+
+	err := tx.builder.rollbackTo(tx.start)
+	tx.builder.closeTransaction(tx)
+
+	return err
+}
+
+// Marks the current state with a name, so RollbackTo can return to it later.
+// A savepoint with the same name is replaced.
+func (tx *Transaction) Savepoint(name string) error {
+	tx.lock()
+	defer tx.unlock()
+
+	if tx.done {
+		return ErrTransactionClosed
+	}
+
+	tx.removeSavepoint(name)
+	tx.savepoints = append(tx.savepoints, savepoint{name, tx.builder.checkpoint()})
+
+	return nil
+}
+
+// Restores the content of the savepoint with the given name. The transaction stays open,
+// savepoints created after the given one are dropped. Returns ErrSavepointNotFound for an unknown name.
+func (tx *Transaction) RollbackTo(name string) error {
+	tx.lock()
+	defer tx.unlock()
+
+	if tx.done {
+		return ErrTransactionClosed
+	}
+
+	for i, sp := range tx.savepoints {
+		if sp.name == name {
+			tx.builder.closeNestedTransactions(tx)
+			err := tx.builder.rollbackTo(sp.checkpoint)
+			tx.savepoints = tx.savepoints[:i+1]
+			return err
+		}
+	}
+
+	return ErrSavepointNotFound
+}
+
+func (tx *Transaction) removeSavepoint(name string) {
+	for i, sp := range tx.savepoints {
+		if sp.name == name {
+			tx.savepoints = append(tx.savepoints[:i], tx.savepoints[i+1:]...)
+			return
+		}
+	}
+}
+
+func (tx *Transaction) lock() {
+	if tx.locker != nil {
+		tx.locker.Lock()
+	}
+}
+
+func (tx *Transaction) unlock() {
+	if tx.locker != nil {
+		tx.locker.Unlock()
+	}
+}
+
+func (s *StringBuilder) checkpoint() checkpoint {
+	return checkpoint{
+		edits:   len(s.journal.edits),
+		length:  s.position,
+		pending: s.pending,
+		err:     s.err,
+	}
+}
+
+// Undoes the logged edits in reverse order and cuts off everything appended behind the old length.
+// Appends only ever add to the end, so after undoing the other edits the old text is the prefix again.
+// As appends are still there while the other edits are undone, the text can temporarily exceed the maximum capacity.
+func (s *StringBuilder) rollbackTo(c checkpoint) error {
+	j := s.journal
+	j.rollingBack = true
+	s.beginEdit()
+
+	var err error
+	for i := len(j.edits) - 1; i >= c.edits; i-- {
+		if applyErr := s.applyEdit(j.edits[i], true, ChangeRollback); applyErr != nil && err == nil {
+			err = applyErr
+		}
+		j.edits[i] = edit{}
+	}
+	j.edits = j.edits[:c.edits]
+
+	if position := s.position; position > c.length {
+		removed := s.captureRemoved(c.length, position)
+		s.position = c.length
+		s.recordRemoved(ChangeRollback, c.length, position, removed)
+	}
+	s.pending = c.pending
+	s.err = c.err
+
+	s.endEdit()
+	j.rollingBack = false
+	s.fitMaxCapacity()
+
+	return err
+}
+
+// Closes the transaction and all transactions nested into it.
+// Once the outermost transaction is closed nothing gets logged anymore.
+func (s *StringBuilder) closeTransaction(tx *Transaction) {
+	j := s.journal
+	for i, open := range j.open {
+		if open == tx {
+			for _, nested := range j.open[i:] {
+				nested.done = true
+			}
+			j.open = j.open[:i]
+			break
+		}
+	}
+
+	if len(j.open) == 0 {
+		s.journal = nil
+	}
+}
+
+// Closes the transactions nested into tx, which go away when tx returns to a savepoint
+func (s *StringBuilder) closeNestedTransactions(tx *Transaction) {
+	j := s.journal
+	for i, open := range j.open {
+		if open == tx {
+			for _, nested := range j.open[i+1:] {
+				nested.done = true
+			}
+			j.open = j.open[:i+1]
+			return
+		}
+	}
+}
+
+// Reports whether edits have to be logged for open transactions
+func (s *StringBuilder) journaling() bool {
+	return s.journal != nil && !s.journal.rollingBack
+}
+
+// Logs an edit for the open transactions. A reversal can't be undone once text was appended behind it,
+// so it is logged as a replacement of the whole text.
+func (s *StringBuilder) journalEdit(e edit) {
+	if !s.journaling() {
+		return
+	}
+
+	if e.reversed {
+		inserted := make([]rune, s.position)
+		copy(inserted, s.data[:s.position])
+		removed := make([]rune, s.position)
+		for i, r := range inserted {
+			removed[len(removed)-1-i] = r
+		}
+		e = edit{start: 0, removed: removed, inserted: inserted}
+	}
+
+	s.journal.edits = append(s.journal.edits, e)
+}
@@ -0,0 +1,227 @@
+package Text
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRollbackRestoresContent(t *testing.T) {
+	tests := []struct {
+		name string
+		edit func(s *StringBuilder)
+	}{
+		{"Append", func(s *StringBuilder) { s.Append(" and more").AppendLine("!") }},
+		{"Insert", func(s *StringBuilder) { s.Insert(5, " my dear") }},
+		{"Remove", func(s *StringBuilder) { s.Remove(2, 5) }},
+		{"Replace", func(s *StringBuilder) { s.Replace("l", "LLL") }},
+		{"Trim", func(s *StringBuilder) { s.Trim() }},
+		{"Reverse", func(s *StringBuilder) { s.Reverse() }},
+		{"SetRuneAt", func(s *StringBuilder) { s.SetRuneAt(2, 'J') }},
+		{"Clear", func(s *StringBuilder) { s.Clear() }},
+		{"Mixed", func(s *StringBuilder) {
+			s.Append("abc")
+			s.Reverse()
+			s.Append("def")
+			s.Remove(0, 10)
+			s.Insert(3, "xyz")
+			s.Append("ghi")
+			s.Replace("x", "")
+			s.Write([]byte{0xC3})
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			const text = "  Hello World, hello Gophers  "
+			s := NewStringBuilderFromString(text)
+			tx := s.Begin()
+
+			tt.edit(s)
+			if err := tx.Rollback(); err != nil {
+				t.Fatalf("Transaction.Rollback() threw an error: %v", err)
+			}
+
+			if got := s.ToString(); got != text || s.Len() != len(text) {
+				t.Errorf("StringBuilder after Rollback = %q, want %q", got, text)
+			}
+			s.Write([]byte{0xB6})
+			if got := s.ToString(); got != text+"�" {
+				t.Errorf("StringBuilder kept bytes of an incomplete rune from the transaction: %q", got)
+			}
+		})
+	}
+}
+
+func TestCommitKeepsContent(t *testing.T) {
+	s := NewStringBuilderFromString("Hello")
+	tx := s.Begin()
+	s.Append(" World")
+
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("Transaction.Commit() threw an error: %v", err)
+	}
+
+	if got := s.ToString(); got != "Hello World" {
+		t.Errorf("StringBuilder after Commit = %q, want %q", got, "Hello World")
+	}
+	if err := tx.Rollback(); !errors.Is(err, ErrTransactionClosed) {
+		t.Errorf("Transaction.Rollback() after Commit = %v, want %v", err, ErrTransactionClosed)
+	}
+	if s.journal != nil {
+		t.Errorf("StringBuilder keeps logging edits after the last transaction was closed")
+	}
+}
+
+func TestRollbackOfAppendsOnlyTruncates(t *testing.T) {
+	s := NewStringBuilderFromString("Hello")
+	tx := s.Begin()
+
+	s.Append(" World").AppendRune('!').AppendInt(42)
+
+	if len(s.journal.edits) != 0 {
+		t.Errorf("Appends logged %v edits, want none", len(s.journal.edits))
+	}
+	tx.Rollback()
+	if got := s.ToString(); got != "Hello" {
+		t.Errorf("StringBuilder after Rollback = %q, want %q", got, "Hello")
+	}
+}
+
+func TestNestedTransactions(t *testing.T) {
+	s := NewStringBuilderFromString("Hello")
+	outer := s.Begin()
+	s.Append(" World")
+
+	inner := s.Begin()
+	s.Replace("World", "Gophers")
+	inner.Commit()
+
+	nested := s.Begin()
+	s.Insert(0, ">> ")
+	nested.Rollback()
+
+	if got := s.ToString(); got != "Hello Gophers" {
+		t.Errorf("StringBuilder after the inner transactions = %q, want %q", got, "Hello Gophers")
+	}
+
+	outer.Rollback()
+	if got := s.ToString(); got != "Hello" {
+		t.Errorf("StringBuilder after the outer Rollback = %q, want %q", got, "Hello")
+	}
+}
+
+func TestRollbackClosesNestedTransactions(t *testing.T) {
+	s := NewStringBuilderFromString("Hello")
+	outer := s.Begin()
+	inner := s.Begin()
+	s.Append(" World")
+
+	outer.Rollback()
+
+	if err := inner.Commit(); !errors.Is(err, ErrTransactionClosed) {
+		t.Errorf("Transaction.Commit() of a rolled back nested transaction = %v, want %v", err, ErrTransactionClosed)
+	}
+}
+
+func TestSavepoints(t *testing.T) {
+	s := NewStringBuilderFromString("SELECT *")
+	tx := s.Begin()
+	s.Append(" FROM users")
+	tx.Savepoint("from")
+	s.Append(" WHERE")
+	tx.Savepoint("where")
+	s.Append(" id = 1")
+	s.Replace("*", "name")
+
+	if err := tx.RollbackTo("where"); err != nil {
+		t.Fatalf("Transaction.RollbackTo() threw an error: %v", err)
+	}
+	if got := s.ToString(); got != "SELECT * FROM users WHERE" {
+		t.Errorf("StringBuilder after RollbackTo = %q, want %q", got, "SELECT * FROM users WHERE")
+	}
+
+	tx.RollbackTo("from")
+	if got := s.ToString(); got != "SELECT * FROM users" {
+		t.Errorf("StringBuilder after RollbackTo = %q, want %q", got, "SELECT * FROM users")
+	}
+	if err := tx.RollbackTo("where"); !errors.Is(err, ErrSavepointNotFound) {
+		t.Errorf("Transaction.RollbackTo() of a dropped savepoint = %v, want %v", err, ErrSavepointNotFound)
+	}
+
+	s.Append(" LIMIT 1")
+	tx.Commit()
+	if got := s.ToString(); got != "SELECT * FROM users LIMIT 1" {
+		t.Errorf("StringBuilder after Commit = %q, want %q", got, "SELECT * FROM users LIMIT 1")
+	}
+}
+
+func TestRollbackCanBeUndone(t *testing.T) {
+	s := NewStringBuilderFromString("Hello", WithHistory(10))
+	tx := s.Begin()
+	s.Append(" World")
+	s.Insert(0, ">> ")
+
+	tx.Rollback()
+	s.Undo()
+
+	if got := s.ToString(); got != ">> Hello World" {
+		t.Errorf("StringBuilder after undoing the Rollback = %q, want %q", got, ">> Hello World")
+	}
+}
+
+func TestUndoInsideTransactionIsRolledBack(t *testing.T) {
+	s := NewStringBuilderFromString("Hello", WithHistory(10))
+	s.Append(" World")
+	s.Reverse()
+	tx := s.Begin()
+
+	s.Undo()
+	s.Undo()
+	tx.Rollback()
+
+	if got := s.ToString(); got != "dlroW olleH" {
+		t.Errorf("StringBuilder after Rollback = %q, want %q", got, "dlroW olleH")
+	}
+}
+
+func TestRollbackWithMaxCapacity(t *testing.T) {
+	s := NewStringBuilderFromString("abcde", WithMaxCapacity(5), WithHistory(10))
+	tx := s.Begin()
+	s.Remove(0, 3)
+	s.Append("xyz")
+
+	err := tx.Rollback()
+
+	if err != nil {
+		t.Errorf("Rollback() threw an error: %v", err)
+	}
+	if got := s.ToString(); got != "abcde" {
+		t.Errorf("StringBuilder after Rollback = %q, want %q", got, "abcde")
+	}
+	if s.Capacity() > 5 {
+		t.Errorf("Capacity() = %d, want at most 5", s.Capacity())
+	}
+	s.Undo()
+	if got := s.ToString(); got != "dexyz" {
+		t.Errorf("StringBuilder after undoing the Rollback = %q, want %q", got, "dexyz")
+	}
+	if s.Err() != nil || s.Capacity() > 5 {
+		t.Errorf("Undo left Err() = %v and Capacity() = %d", s.Err(), s.Capacity())
+	}
+}
+
+func TestRollbackToSavepointWithMaxCapacity(t *testing.T) {
+	s := NewStringBuilderFromString("abcde", WithMaxCapacity(5))
+	tx := s.Begin()
+	tx.Savepoint("start")
+	s.Remove(0, 3)
+	s.Append("xyz")
+
+	err := tx.RollbackTo("start")
+
+	if err != nil {
+		t.Errorf("RollbackTo() threw an error: %v", err)
+	}
+	if got := s.ToString(); got != "abcde" {
+		t.Errorf("StringBuilder after RollbackTo = %q, want %q", got, "abcde")
+	}
+}
@@ -0,0 +1,447 @@
+package Text
+
+import (
+	"bytes"
+	"sort"
+	"strconv"
+	"unicode/utf8"
+)
+
+// Every runeIndexStride-th rune gets its byte offset stored in the offset index.
+// Lookups decode at most runeIndexStride-1 runes after the closest index entry.
+const runeIndexStride = 64
+
+// Utf8StringBuilder offers the same API as StringBuilder but keeps its content as UTF-8 bytes.
+// All indices are still rune indices. For ASCII-only content rune and byte offsets are the same,
+// otherwise they get translated through a sparse, lazily built rune-to-byte offset index.
+type Utf8StringBuilder struct {
+	data      []byte
+	runeCount int
+	// offsets[k] is the byte offset of rune k*runeIndexStride. Only valid entries are kept.
+	offsets []int
+}
+
+// Creates a new instance of the Utf8StringBuilder with a preallocated buffer of initialCapacity bytes
+func NewUtf8StringBuilder(initialCapacity int) *Utf8StringBuilder {
+	return &Utf8StringBuilder{data: make([]byte, 0, initialCapacity)}
+}
+
+// Creates a new instance of the Utf8StringBuilder with a preallocated text
+func NewUtf8StringBuilderFromString(text string) *Utf8StringBuilder {
+	s := &Utf8StringBuilder{}
+	return s.Append(text)
+}
+
+// Appends a text to the Utf8StringBuilder instance
+func (s *Utf8StringBuilder) Append(text string) *Utf8StringBuilder {
+	text = validUtf8(text)
+	s.data = append(s.data, text...)
+	s.runeCount += utf8.RuneCountInString(text)
+
+	return s
+}
+
+// Appends a text and a new line character to the Utf8StringBuilder instance
+func (s *Utf8StringBuilder) AppendLine(text string) *Utf8StringBuilder {
+	s.Append(text)
+	s.Append("\n")
+
+	return s
+}
+
+// Appends a single character to the Utf8StringBuilder instance
+func (s *Utf8StringBuilder) AppendRune(char rune) *Utf8StringBuilder {
+	s.data = utf8.AppendRune(s.data, char)
+	s.runeCount++
+
+	return s
+}
+
+// Appends a single integer to the Utf8StringBuilder instance
+func (s *Utf8StringBuilder) AppendInt(integer int) *Utf8StringBuilder {
+	before := len(s.data)
+	s.data = strconv.AppendInt(s.data, int64(integer), 10)
+	s.runeCount += len(s.data) - before
+	return s
+}
+
+// Appends a single boolean to the Utf8StringBuilder instance
+func (s *Utf8StringBuilder) AppendBool(flag bool) *Utf8StringBuilder {
+	return s.Append(strconv.FormatBool(flag))
+}
+
+// Appends a list of strings to the Utf8StringBuilder instance
+func (s *Utf8StringBuilder) AppendList(words []string) *Utf8StringBuilder {
+	allWordLength := 0
+	for _, word := range words {
+		allWordLength += len(word)
+	}
+	if len(s.data)+allWordLength > cap(s.data) {
+		s.data = append(make([]byte, 0, len(s.data)+allWordLength), s.data...)
+	}
+	for _, word := range words {
+		s.Append(word)
+	}
+	return s
+}
+
+// Returns the current length of the represented string in runes
+func (s *Utf8StringBuilder) Len() int {
+	return s.runeCount
+}
+
+// Returns the current length of the represented string in bytes
+func (s *Utf8StringBuilder) ByteLen() int {
+	return len(s.data)
+}
+
+// Returns the represented string
+func (s *Utf8StringBuilder) ToString() string {
+	return string(s.data)
+}
+
+// Removes length runes starting at the rune index start
+func (s *Utf8StringBuilder) Remove(start int, length int) error {
+	if err := checkRange("Remove", start, length, s.runeCount); err != nil {
+		return err
+	}
+
+	if length == 0 {
+		return nil
+	}
+
+	from := s.byteOffset(start)
+	to := s.byteOffset(start + length)
+	s.data = append(s.data[:from], s.data[to:]...)
+	s.runeCount -= length
+	s.invalidateFrom(start)
+
+	return nil
+}
+
+// Inserts the text at the given rune index
+func (s *Utf8StringBuilder) Insert(index int, text string) error {
+	if err := checkIndex("Insert", index, s.runeCount, true); err != nil {
+		return err
+	}
+
+	text = validUtf8(text)
+	at := s.byteOffset(index)
+	s.data = append(s.data, text...)
+	copy(s.data[at+len(text):], s.data[at:len(s.data)-len(text)])
+	copy(s.data[at:], text)
+	s.runeCount += utf8.RuneCountInString(text)
+	s.invalidateFrom(index)
+
+	return nil
+}
+
+// Removes all characters from the current instance. This sets the internal size to 0.
+// The internal array will stay the same.
+func (s *Utf8StringBuilder) Clear() {
+	s.data = s.data[:0]
+	s.runeCount = 0
+	s.offsets = s.offsets[:0]
+}
+
+// Gets the rune at the specific position. Panics with a *RangeError if the index is out of range.
+func (s *Utf8StringBuilder) RuneAt(index int) rune {
+	r, err := s.TryRuneAt(index)
+	if err != nil {
+		panic(err)
+	}
+
+	return r
+}
+
+// Gets the rune at the specific position or returns a *RangeError if the index is out of range
+func (s *Utf8StringBuilder) TryRuneAt(index int) (rune, error) {
+	if err := checkIndex("RuneAt", index, s.runeCount, false); err != nil {
+		return 0, err
+	}
+	r, _ := utf8.DecodeRune(s.data[s.byteOffset(index):])
+
+	return r, nil
+}
+
+// Returns the first occurrence of the given text in the string builder. Returns -1 if not found
+func (s *Utf8StringBuilder) FindFirst(text string, opts ...SearchOption) int {
+	if o := newSearchOptions(opts); o.transforming() {
+		return findFirst([]rune(string(s.data)), text, o)
+	}
+	if len(text) == 0 {
+		return -1
+	}
+	at := bytes.Index(s.data, []byte(text))
+	if at < 0 {
+		return -1
+	}
+	return s.runeIndex(at)
+}
+
+// Returns the last occurrence of the given text in the string builder. Returns -1 if not found
+func (s *Utf8StringBuilder) FindLast(text string, opts ...SearchOption) int {
+	if o := newSearchOptions(opts); o.transforming() {
+		return findLast([]rune(string(s.data)), text, o)
+	}
+	if len(text) == 0 {
+		return -1
+	}
+	at := bytes.LastIndex(s.data, []byte(text))
+	if at < 0 {
+		return -1
+	}
+	return s.runeIndex(at)
+}
+
+// Returns all occurrences of the given text in the string builder. Returns an empty if no occurrence found.
+// Occurrences which overlap each other are reported unless the NonOverlapping option is given.
+func (s *Utf8StringBuilder) FindAll(text string, opts ...SearchOption) []int {
+	o := newSearchOptions(opts)
+	if o.transforming() {
+		return findAll([]rune(string(s.data)), text, o)
+	}
+
+	items := make([]int, 0, 8)
+	if len(text) == 0 {
+		return items
+	}
+
+	needle := []byte(text)
+	step := len(needle)
+	if o.overlapping {
+		_, step = utf8.DecodeRune(needle)
+	}
+	lastByte, lastRune := 0, 0
+	for from := 0; from <= len(s.data)-len(needle); {
+		at := bytes.Index(s.data[from:], needle)
+		if at < 0 {
+			break
+		}
+		at += from
+		lastRune += utf8.RuneCount(s.data[lastByte:at])
+		lastByte = at
+		items = append(items, lastRune)
+		from = at + step
+	}
+
+	return items
+}
+
+// Replaces all occurrences of oldValue with newValue
+func (s *Utf8StringBuilder) ReplaceRune(oldValue rune, newValue rune) *Utf8StringBuilder {
+	if oldValue < utf8.RuneSelf && newValue < utf8.RuneSelf {
+		for i, b := range s.data {
+			if b == byte(oldValue) {
+				s.data[i] = byte(newValue)
+			}
+		}
+		return s
+	}
+
+	return s.Replace(string(oldValue), string(newValue))
+}
+
+// Replaces all occurrences of oldValue with newValue
+func (s *Utf8StringBuilder) Replace(oldValue string, newValue string) *Utf8StringBuilder {
+	if oldValue == newValue || len(oldValue) == 0 {
+		return s
+	}
+
+	oldValueBytes := []byte(oldValue)
+	occurrences := bytes.Count(s.data, oldValueBytes)
+	if occurrences == 0 {
+		return s
+	}
+
+	newValue = validUtf8(newValue)
+	delta := utf8.RuneCountInString(newValue) - utf8.RuneCountInString(oldValue)
+	s.data = bytes.Replace(s.data, oldValueBytes, []byte(newValue), -1)
+	s.runeCount += delta * occurrences
+	s.invalidateFrom(0)
+
+	return s
+}
+
+// Implements the io.Writer interface so the Utf8StringBuilder can be used with fmt.Printf
+func (s *Utf8StringBuilder) Write(p []byte) (int, error) {
+	s.Append(string(p))
+
+	return len(p), nil
+}
+
+// Trims the given characters from the start and end of the string builder or all whitespaces if no characters are given
+func (s *Utf8StringBuilder) Trim(chars ...rune) *Utf8StringBuilder {
+	return s.TrimStart(chars...).TrimEnd(chars...)
+}
+
+// Trims the given characters from the start of the string builder or all whitespaces if no characters are given
+func (s *Utf8StringBuilder) TrimStart(chars ...rune) *Utf8StringBuilder {
+	trimSet := createTrimSet(chars...)
+	start, runes := 0, 0
+
+	for start < len(s.data) {
+		r, size := utf8.DecodeRune(s.data[start:])
+		if _, exists := trimSet[r]; !exists {
+			break
+		}
+		start += size
+		runes++
+	}
+
+	if start > 0 {
+		s.data = append(s.data[:0], s.data[start:]...)
+		s.runeCount -= runes
+		s.invalidateFrom(0)
+	}
+
+	return s
+}
+
+// Trims the given characters from the end of the string builder or all whitespaces if no characters are given
+func (s *Utf8StringBuilder) TrimEnd(chars ...rune) *Utf8StringBuilder {
+	trimSet := createTrimSet(chars...)
+	end := len(s.data)
+
+	for end > 0 {
+		r, size := utf8.DecodeLastRune(s.data[:end])
+		if _, exists := trimSet[r]; !exists {
+			break
+		}
+		end -= size
+		s.runeCount--
+	}
+
+	s.data = s.data[:end]
+	s.invalidateFrom(s.runeCount)
+
+	return s
+}
+
+// Reverses the characters of a string builder
+func (s *Utf8StringBuilder) Reverse() *Utf8StringBuilder {
+	if s.runeCount != len(s.data) {
+		// Reverse the bytes of every multi-byte rune first so the final
+		// reversal of the whole buffer restores their encoding
+		for i := 0; i < len(s.data); {
+			_, size := utf8.DecodeRune(s.data[i:])
+			reverseBytes(s.data[i : i+size])
+			i += size
+		}
+	}
+	reverseBytes(s.data)
+	s.invalidateFrom(0)
+
+	return s
+}
+
+// Returns a substring from start (inclusive) to end (exclusive).
+func (s *Utf8StringBuilder) Substring(start, end int) (string, error) {
+	if err := checkBounds("Substring", start, end, s.runeCount); err != nil {
+		return "", err
+	}
+
+	return string(s.data[s.byteOffset(start):s.byteOffset(end)]), nil
+}
+
+// Sets the rune at the specific position
+func (s *Utf8StringBuilder) SetRuneAt(index int, val rune) error {
+	if err := checkIndex("SetRuneAt", index, s.runeCount, false); err != nil {
+		return err
+	}
+
+	at := s.byteOffset(index)
+	_, oldSize := utf8.DecodeRune(s.data[at:])
+	if !utf8.ValidRune(val) {
+		val = utf8.RuneError
+	}
+	newSize := utf8.RuneLen(val)
+	if oldSize != newSize {
+		s.data = append(s.data[:at], append(make([]byte, newSize), s.data[at+oldSize:]...)...)
+		s.invalidateFrom(index)
+	}
+	utf8.EncodeRune(s.data[at:], val)
+
+	return nil
+}
+
+// Returns the byte offset of the rune with the given index
+func (s *Utf8StringBuilder) byteOffset(runeIndex int) int {
+	if s.runeCount == len(s.data) {
+		return runeIndex
+	}
+	if runeIndex == s.runeCount {
+		return len(s.data)
+	}
+
+	block := runeIndex / runeIndexStride
+	s.extendIndex(func(last int) bool { return len(s.offsets) > block })
+
+	offset := s.offsets[block]
+	for i := block * runeIndexStride; i < runeIndex; i++ {
+		_, size := utf8.DecodeRune(s.data[offset:])
+		offset += size
+	}
+
+	return offset
+}
+
+// Returns the rune index of the rune starting at the given byte offset
+func (s *Utf8StringBuilder) runeIndex(byteOffset int) int {
+	if s.runeCount == len(s.data) {
+		return byteOffset
+	}
+
+	s.extendIndex(func(last int) bool { return last >= byteOffset })
+
+	block := sort.SearchInts(s.offsets, byteOffset+1) - 1
+
+	return block*runeIndexStride + utf8.RuneCount(s.data[s.offsets[block]:byteOffset])
+}
+
+// Adds index entries until done reports true for the byte offset of the last entry
+// or the end of the buffer is reached
+func (s *Utf8StringBuilder) extendIndex(done func(last int) bool) {
+	if len(s.offsets) == 0 {
+		s.offsets = append(s.offsets, 0)
+	}
+
+	for {
+		offset := s.offsets[len(s.offsets)-1]
+		if done(offset) {
+			return
+		}
+
+		i := 0
+		for ; i < runeIndexStride && offset < len(s.data); i++ {
+			_, size := utf8.DecodeRune(s.data[offset:])
+			offset += size
+		}
+		if i < runeIndexStride {
+			return
+		}
+		s.offsets = append(s.offsets, offset)
+	}
+}
+
+// Drops all index entries which might have been affected by a change at the given rune index
+func (s *Utf8StringBuilder) invalidateFrom(runeIndex int) {
+	if keep := runeIndex/runeIndexStride + 1; keep < len(s.offsets) {
+		s.offsets = s.offsets[:keep]
+	}
+}
+
+func reverseBytes(b []byte) {
+	for left, right := 0, len(b)-1; left < right; left, right = left+1, right-1 {
+		b[left], b[right] = b[right], b[left]
+	}
+}
+
+// Returns text with every invalid byte replaced by utf8.RuneError, the same way a []rune conversion does
+func validUtf8(text string) string {
+	if utf8.ValidString(text) {
+		return text
+	}
+
+	return string([]rune(text))
+}
@@ -0,0 +1,232 @@
+package Text
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestUtf8Append(t *testing.T) {
+	tests := []struct {
+		want string
+		len  int
+	}{
+		{"Hello World", 11},
+		{"Hallöchen", 9},
+		{"汉字汉字汉字汉字汉字汉字", 12},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			s := &Utf8StringBuilder{}
+
+			s.Append(tt.want)
+
+			if got := s.ToString(); got != tt.want {
+				t.Errorf("Utf8StringBuilder.Append() = %v, want %v", got, tt.want)
+			}
+			if got := s.Len(); got != tt.len {
+				t.Errorf("Utf8StringBuilder.Len() = %v, want %v", got, tt.len)
+			}
+		})
+	}
+}
+
+func TestUtf8AppendMultipleTypes(t *testing.T) {
+	s := NewUtf8StringBuilder(4)
+
+	s.Append("hö").AppendInt(-12).AppendBool(true).AppendRune('字').AppendList([]string{"a", "ä"}).AppendLine("")
+
+	if got := s.ToString(); got != "hö-12true字aä\n" {
+		t.Errorf("Utf8StringBuilder.Append Multiple types = %q, want %q", got, "hö-12true字aä\n")
+	}
+	if got := s.Len(); got != 13 {
+		t.Errorf("Utf8StringBuilder.Len() = %v, want %v", got, 13)
+	}
+}
+
+func TestUtf8RuneAtWithLongText(t *testing.T) {
+	text := strings.Repeat("aö汉😀", 100)
+	runes := []rune(text)
+	s := NewUtf8StringBuilderFromString(text)
+
+	for i := len(runes) - 1; i >= 0; i-- {
+		if got := s.RuneAt(i); got != runes[i] {
+			t.Fatalf("Utf8StringBuilder.RuneAt(%d) = %q, want %q", i, got, runes[i])
+		}
+	}
+}
+
+func TestUtf8InsertAndRemove(t *testing.T) {
+	s := NewUtf8StringBuilderFromString("Hällo World")
+
+	if err := s.Insert(5, " my dear"); err != nil {
+		t.Errorf("Insert threw an error: %v", err)
+	}
+	if err := s.Remove(1, 1); err != nil {
+		t.Errorf("Remove threw an error: %v", err)
+	}
+
+	if got := s.ToString(); got != "Hllo my dear World" {
+		t.Errorf("Actual %q, Expected: %q", got, "Hllo my dear World")
+	}
+}
+
+func TestUtf8BoundsErrors(t *testing.T) {
+	s := NewUtf8StringBuilderFromString("Hällo")
+
+	if err := s.Remove(4, 2); err == nil {
+		t.Error("Remove should throw error but did not")
+	}
+	if err := s.Remove(-1, 1); err == nil {
+		t.Error("Remove should throw error but did not")
+	}
+	if err := s.Insert(6, "a"); err == nil {
+		t.Error("Insert should throw error but did not")
+	}
+	if _, err := s.Substring(0, 6); err == nil {
+		t.Error("Substring should throw error but did not")
+	}
+	if err := s.SetRuneAt(5, 'a'); err == nil {
+		t.Error("SetRuneAt should throw error but did not")
+	}
+}
+
+func TestUtf8Find(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		needle string
+		first  int
+		last   int
+		all    []int
+	}{
+		{"Empty haystack", "", "n", -1, -1, []int{}},
+		{"Empty needle", "n", "", -1, -1, []int{}},
+		{"Hello in Hello World", "Hello World", "Hello", 0, 0, []int{0}},
+		{"ö in Hellöö", "Hellöö", "ö", 4, 5, []int{4, 5}},
+		{"Overlapping", "äääa", "ää", 0, 1, []int{0, 1}},
+		{"Multibyte", "汉字汉字", "字", 1, 3, []int{1, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewUtf8StringBuilderFromString(tt.input)
+			if got := s.FindFirst(tt.needle); got != tt.first {
+				t.Errorf("Utf8StringBuilder.FindFirst() = %v, want %v", got, tt.first)
+			}
+			if got := s.FindLast(tt.needle); got != tt.last {
+				t.Errorf("Utf8StringBuilder.FindLast() = %v, want %v", got, tt.last)
+			}
+			if got := s.FindAll(tt.needle); !slicesEqual(got, tt.all) {
+				t.Errorf("Utf8StringBuilder.FindAll() = %v, want %v", got, tt.all)
+			}
+		})
+	}
+}
+
+func TestUtf8Replace(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		oldValue string
+		newValue string
+		want     string
+	}{
+		{"Replace Hello with Ha", "Hello World", "Hello", "Ha", "Ha World"},
+		{"Replace Hello with Hallöchen", "Hello World Hello", "Hello", "Hallöchen", "Hallöchen World Hallöchen"},
+		{"Replace ö with ä", "äö", "ö", "ä", "ää"},
+		{"Replace with same word", "Hello", "llo", "llo", "Hello"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewUtf8StringBuilderFromString(tt.input)
+
+			s.Replace(tt.oldValue, tt.newValue)
+
+			if got := s.ToString(); got != tt.want {
+				t.Errorf("Utf8StringBuilder.Replace() = %v, want %v", got, tt.want)
+			}
+			if got := s.Len(); got != len([]rune(tt.want)) {
+				t.Errorf("Utf8StringBuilder.Len() = %v, want %v", got, len([]rune(tt.want)))
+			}
+		})
+	}
+}
+
+func TestUtf8ReplaceRune(t *testing.T) {
+	s := NewUtf8StringBuilderFromString("Hello Wörld")
+
+	s.ReplaceRune('l', 'm').ReplaceRune('ö', 'o')
+
+	if got := s.ToString(); got != "Hemmo Wormd" {
+		t.Errorf("Utf8StringBuilder.ReplaceRune() = %v, want %v", got, "Hemmo Wormd")
+	}
+}
+
+func TestUtf8TrimAndReverse(t *testing.T) {
+	s := NewUtf8StringBuilderFromString("  ääHallo😀ää \n")
+
+	s.Trim().Trim('ä').Reverse()
+
+	if got := s.ToString(); got != "😀ollaH" {
+		t.Errorf("Utf8StringBuilder.Trim().Reverse() = %q, want %q", got, "😀ollaH")
+	}
+	if got := s.Len(); got != 6 {
+		t.Errorf("Utf8StringBuilder.Len() = %v, want %v", got, 6)
+	}
+}
+
+func TestUtf8SubstringAndSetRuneAt(t *testing.T) {
+	s := NewUtf8StringBuilderFromString("abcdö")
+
+	if err := s.SetRuneAt(1, '汉'); err != nil {
+		t.Errorf("SetRuneAt threw an error: %v", err)
+	}
+	got, err := s.Substring(1, 5)
+	if err != nil {
+		t.Errorf("Substring threw an error: %v", err)
+	}
+
+	if got != "汉cdö" {
+		t.Errorf("Utf8StringBuilder.Substring() = %q, want %q", got, "汉cdö")
+	}
+}
+
+func TestUtf8Write(t *testing.T) {
+	s := &Utf8StringBuilder{}
+
+	n, _ := fmt.Fprintf(s, "%d...%s", 3, "ö")
+
+	if got := s.ToString(); got != "3...ö" {
+		t.Errorf("Utf8StringBuilder.Write() = %v, want %v", got, "3...ö")
+	}
+	if n != 6 {
+		t.Errorf("Utf8StringBuilder.Write() returned %v, want %v", n, 6)
+	}
+}
+
+func TestUtf8MatchesStringBuilderAfterEdits(t *testing.T) {
+	text := strings.Repeat("Grüße aus 東京 😀! ", 20)
+	utf8Builder := NewUtf8StringBuilderFromString(text)
+	runeBuilder := NewStringBuilderFromString(text)
+
+	for i := 0; i < 30; i++ {
+		index := (i * 37) % runeBuilder.Len()
+		utf8Builder.Insert(index, "ß字")
+		runeBuilder.Insert(index, "ß字")
+		utf8Builder.Remove(index/2, 3)
+		runeBuilder.Remove(index/2, 3)
+
+		if utf8Builder.FindLast("東京") != runeBuilder.FindLast("東京") {
+			t.Fatalf("FindLast mismatch after edit %d", i)
+		}
+	}
+
+	if got, want := utf8Builder.ToString(), runeBuilder.ToString(); got != want {
+		t.Errorf("Utf8StringBuilder = %q, want %q", got, want)
+	}
+	for i := 0; i < runeBuilder.Len(); i++ {
+		if utf8Builder.RuneAt(i) != runeBuilder.RuneAt(i) {
+			t.Fatalf("RuneAt(%d) mismatch", i)
+		}
+	}
+}